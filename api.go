@@ -0,0 +1,238 @@
+package main
+
+// Versioned REST API on /api/v1/. Unlike the endpoints the UI grew up with
+// it uses proper methods and status codes, and every error comes back as a
+// JSON envelope: {"Error": {"Status": 404, "Code": "not_found", "Message": ...}}
+//
+//	GET    /api/v1/datasources         list, takes the filters and formats of /json/
+//	GET    /api/v1/datasources/{name}  a single data source
+//	DELETE /api/v1/datasources/{name}  delete it (needs -d)
+//	GET    /api/v1/config              the configuration
+//	GET    /api/v1/openapi.json        OpenAPI description of all this
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+)
+
+type (
+	apiError struct {
+		Status  int
+		Code    string
+		Message string
+	}
+
+	apiErrorEnvelope struct {
+		Error apiError
+	}
+)
+
+const apiPrefix = "/api/v1/"
+
+func writeAPIError(w http.ResponseWriter, status int, code string, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(apiErrorEnvelope{apiError{status, code, message}})
+}
+
+func writeAPIJSON(w http.ResponseWriter, status int, v interface{}) {
+	js, err := json.Marshal(v)
+	if err != nil {
+		writeAPIError(w, http.StatusInternalServerError, "internal_error", err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(js)
+}
+
+// Refuses the request with a 405 unless its method is one of allowed
+func allowMethods(w http.ResponseWriter, r *http.Request, allowed ...string) bool {
+	for _, m := range allowed {
+		if r.Method == m {
+			return true
+		}
+	}
+	w.Header().Set("Allow", strings.Join(allowed, ", "))
+	writeAPIError(w, http.StatusMethodNotAllowed, "method_not_allowed", r.Method+" is not allowed on "+r.URL.Path)
+	return false
+}
+
+func apiHandler(w http.ResponseWriter, r *http.Request) {
+	resource := strings.TrimPrefix(r.URL.Path, apiPrefix)
+	switch {
+	case resource == "datasources" || resource == "datasources/":
+		if allowMethods(w, r, "GET") {
+			apiListDatasources(w, r)
+		}
+	case strings.HasPrefix(resource, "datasources/"):
+		name := strings.TrimPrefix(resource, "datasources/")
+		if allowMethods(w, r, "GET", "DELETE") {
+			if r.Method == "DELETE" {
+				apiDeleteDatasource(w, r, name)
+			} else {
+				apiGetDatasource(w, r, name)
+			}
+		}
+	case resource == "config":
+		if allowMethods(w, r, "GET") {
+			writeAPIJSON(w, http.StatusOK, C)
+		}
+	case resource == "openapi.json":
+		if allowMethods(w, r, "GET") {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(openAPIDocument))
+		}
+	default:
+		writeAPIError(w, http.StatusNotFound, "not_found", "No such resource: "+r.URL.Path)
+	}
+}
+
+func apiListDatasources(w http.ResponseWriter, r *http.Request) {
+	if format := exportFormat(r); len(format) > 0 {
+		if !writeExport(w, r, format) {
+			writeAPIError(w, http.StatusBadRequest, "bad_format", "format should be json, csv or ndjson")
+		}
+		return
+	}
+	writeAPIJSON(w, http.StatusOK, getFilteredDSs(newFilter(r)))
+}
+
+func apiGetDatasource(w http.ResponseWriter, r *http.Request, name string) {
+	ds := getDSbyName(name)
+	if len(ds.Name) == 0 {
+		writeAPIError(w, http.StatusNotFound, "datasource_not_found", "No such data source: "+name)
+		return
+	}
+	writeAPIJSON(w, http.StatusOK, ds)
+}
+
+func apiDeleteDatasource(w http.ResponseWriter, r *http.Request, name string) {
+	l := log.New(os.Stdout, "api	", myLogFormat)
+	if !C.AllowDsDeletes {
+		writeAPIError(w, http.StatusForbidden, "deletes_disabled", "Deleting data sources is not enabled (-d)")
+		return
+	}
+	ds := getDSbyName(name)
+	if len(ds.Name) == 0 {
+		writeAPIError(w, http.StatusNotFound, "datasource_not_found", "No such data source: "+name)
+		return
+	}
+	if len(ds.filename) == 0 && len(ds.Upstream) == 0 {
+		writeAPIError(w, http.StatusConflict, "not_deletable", "No whisper file known for data source: "+name)
+		return
+	}
+
+	success := deleteDatasource(ds)
+	l.Printf("DELETE called for '%v' (filename: %v, upstream: %v) with result: '%v'", name, ds.filename, ds.Upstream, success)
+	if !success {
+		writeAPIError(w, http.StatusInternalServerError, "delete_failed", "Could not delete data source: "+name)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// OpenAPI 3 description of the API, served on /api/v1/openapi.json
+const openAPIDocument = `{
+  "openapi": "3.0.3",
+  "info": {
+    "title": "Graphite News",
+    "description": "New data sources found in Graphite",
+    "version": "1"
+  },
+  "servers": [{"url": "/api/v1"}],
+  "paths": {
+    "/datasources": {
+      "get": {
+        "summary": "List new data sources",
+        "parameters": [
+          {"name": "prefix", "in": "query", "schema": {"type": "string"}, "description": "Graphite style glob the name or one of its parents has to match"},
+          {"name": "host", "in": "query", "schema": {"type": "string"}},
+          {"name": "instance", "in": "query", "schema": {"type": "string"}},
+          {"name": "logfile", "in": "query", "schema": {"type": "string"}},
+          {"name": "upstream", "in": "query", "schema": {"type": "string"}},
+          {"name": "format", "in": "query", "schema": {"type": "string", "enum": ["json", "csv", "ndjson"]}},
+          {"name": "history", "in": "query", "schema": {"type": "string"}, "description": "Set to export the whole create history (csv and ndjson only)"}
+        ],
+        "responses": {
+          "200": {
+            "description": "The data sources",
+            "content": {
+              "application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/Datasource"}}},
+              "text/csv": {"schema": {"type": "string"}},
+              "application/x-ndjson": {"schema": {"type": "string"}}
+            }
+          },
+          "400": {"$ref": "#/components/responses/Error"},
+          "405": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/datasources/{name}": {
+      "parameters": [{"name": "name", "in": "path", "required": true, "schema": {"type": "string"}, "example": "local.random.diceroll"}],
+      "get": {
+        "summary": "Get a single data source",
+        "responses": {
+          "200": {"description": "The data source", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Datasource"}}}},
+          "404": {"$ref": "#/components/responses/Error"}
+        }
+      },
+      "delete": {
+        "summary": "Delete a data source and its whisper file",
+        "responses": {
+          "204": {"description": "Deleted"},
+          "403": {"$ref": "#/components/responses/Error"},
+          "404": {"$ref": "#/components/responses/Error"},
+          "409": {"$ref": "#/components/responses/Error"},
+          "500": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/config": {
+      "get": {
+        "summary": "Configuration of this graphite-news",
+        "responses": {"200": {"description": "The configuration", "content": {"application/json": {"schema": {"type": "object"}}}}}
+      }
+    }
+  },
+  "components": {
+    "schemas": {
+      "Datasource": {
+        "type": "object",
+        "properties": {
+          "Name": {"type": "string"},
+          "Create_date": {"type": "string", "format": "date-time"},
+          "Params": {"type": "string"},
+          "Logfile": {"type": "string"},
+          "Instance": {"type": "string"},
+          "Host": {"type": "string"},
+          "Upstream": {"type": "string"},
+          "IdPattern": {"type": "string"},
+          "Violations": {"type": "array", "items": {"type": "object", "properties": {"Rule": {"type": "string"}, "Severity": {"type": "string"}, "Message": {"type": "string"}}}},
+          "Backend": {"type": "string", "description": "Name of the graphite-web it renders from, see /config"},
+          "GrafanaURL": {"type": "string", "description": "Link to it in Grafana's Explore, only with -gf"}
+        }
+      },
+      "Error": {
+        "type": "object",
+        "properties": {
+          "Error": {
+            "type": "object",
+            "properties": {
+              "Status": {"type": "integer"},
+              "Code": {"type": "string"},
+              "Message": {"type": "string"}
+            }
+          }
+        }
+      }
+    },
+    "responses": {
+      "Error": {"description": "Something went wrong", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Error"}}}}
+    }
+  }
+}
+`
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+func apiRequest(method string, url string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(method, url, nil)
+	w := httptest.NewRecorder()
+	apiHandler(w, req)
+	return w
+}
+
+func TestAPIErrors(t *testing.T) {
+	State.Vals = nil // start fresh
+	defer func() { State.Vals = nil }()
+	defer func(allow bool) { C.AllowDsDeletes = allow }(C.AllowDsDeletes)
+	addItemToState(Datasource{Name: "local.random.ingested"})
+
+	type testpair struct {
+		method string
+		url    string
+		status int
+		code   string
+		allow  bool
+	}
+	var testCases = []testpair{
+		{"GET", "/api/v1/bogus", http.StatusNotFound, "not_found", false},
+		{"POST", "/api/v1/datasources", http.StatusMethodNotAllowed, "method_not_allowed", false},
+		{"PUT", "/api/v1/datasources/local.random.ingested", http.StatusMethodNotAllowed, "method_not_allowed", false},
+		{"GET", "/api/v1/datasources/no.such.thing", http.StatusNotFound, "datasource_not_found", false},
+		{"GET", "/api/v1/datasources?format=xml", http.StatusBadRequest, "bad_format", false},
+		{"DELETE", "/api/v1/datasources/local.random.ingested", http.StatusForbidden, "deletes_disabled", false},
+		{"DELETE", "/api/v1/datasources/no.such.thing", http.StatusNotFound, "datasource_not_found", true},
+		{"DELETE", "/api/v1/datasources/local.random.ingested", http.StatusConflict, "not_deletable", true},
+	}
+	for _, test := range testCases {
+		C.AllowDsDeletes = test.allow
+		w := apiRequest(test.method, test.url)
+		var e apiErrorEnvelope
+		if err := json.Unmarshal(w.Body.Bytes(), &e); err != nil || w.Code != test.status || e.Error.Status != test.status || e.Error.Code != test.code {
+			t.Fatal(fmt.Sprintf("Expected %v %v to fail with %v %v, got %v %v", test.method, test.url, test.status, test.code, w.Code, w.Body.String()))
+		}
+	}
+	if w := apiRequest("POST", "/api/v1/datasources/x"); w.Header().Get("Allow") != "GET, DELETE" {
+		t.Fatal(fmt.Sprintf("Expected an Allow header on a 405, got [%v]", w.Header().Get("Allow")))
+	}
+}
+
+func TestAPIDatasources(t *testing.T) {
+	State.Vals = nil // start fresh
+	defer func() { State.Vals = nil }()
+	defer func(allow bool) { C.AllowDsDeletes = allow }(C.AllowDsDeletes)
+	C.AllowDsDeletes = true
+
+	file, _ := ioutil.TempFile("", "graphite-news-api")
+	file.Close()
+	defer os.Remove(file.Name())
+	addItemToState(Datasource{Name: "local.random.diceroll", filename: file.Name(), Origin: Origin{Host: "carbon1"}})
+	addItemToState(Datasource{Name: "app.payments.count", Origin: Origin{Host: "carbon2"}})
+
+	var dss []Datasource
+	w := apiRequest("GET", "/api/v1/datasources?host=carbon1")
+	if err := json.Unmarshal(w.Body.Bytes(), &dss); err != nil || len(dss) != 1 || dss[0].Name != "local.random.diceroll" {
+		t.Fatal(fmt.Sprintf("Expected filtered list, got %v", w.Body.String()))
+	}
+
+	var ds Datasource
+	w = apiRequest("GET", "/api/v1/datasources/app.payments.count")
+	if err := json.Unmarshal(w.Body.Bytes(), &ds); err != nil || w.Code != http.StatusOK || ds.Host != "carbon2" {
+		t.Fatal(fmt.Sprintf("Expected data source, got %v %v", w.Code, w.Body.String()))
+	}
+
+	w = apiRequest("DELETE", "/api/v1/datasources/local.random.diceroll")
+	if w.Code != http.StatusNoContent || len(getDSbyName("local.random.diceroll").Name) > 0 {
+		t.Fatal(fmt.Sprintf("Expected data source to be deleted, got %v %v", w.Code, w.Body.String()))
+	}
+	if _, err := os.Stat(file.Name()); !os.IsNotExist(err) {
+		t.Fatal("Whisper file was not deleted")
+	}
+
+	w = apiRequest("GET", "/api/v1/openapi.json")
+	var doc map[string]interface{}
+	if err := json.Unmarshal(w.Body.Bytes(), &doc); err != nil || doc["openapi"] != "3.0.3" {
+		t.Fatal(fmt.Sprintf("OpenAPI document is not valid JSON: %v", err))
+	}
+
+	// every field of a data source should be in the schema
+	var schema struct {
+		Components struct {
+			Schemas struct {
+				Datasource struct {
+					Properties map[string]interface{}
+				}
+			}
+		}
+	}
+	json.Unmarshal(w.Body.Bytes(), &schema)
+	var fields map[string]interface{}
+	js, _ := json.Marshal(Datasource{GrafanaURL: "http://grafana"})
+	json.Unmarshal(js, &fields)
+	for field := range fields {
+		if _, ok := schema.Components.Schemas.Datasource.Properties[field]; !ok {
+			t.Fatal(fmt.Sprintf("Datasource schema is missing %v", field))
+		}
+	}
+}
@@ -10,6 +10,9 @@ gn.getConfig = function() {
 		gn.JsonPullInterval = data.JsonPullInterval;
 		gn.GraphiteURL = data.GraphiteURL;
 		gn.AllowDsDeletes = data.AllowDsDeletes;
+		gn.LocalRender = data.LocalRender;
+		gn.ProxyRender = data.ProxyRender;
+		gn.Backends = data.Backends;
 		gn.Version = data.Version;
 		gn.CompileTime = data.CompileTime;
 
@@ -30,8 +33,27 @@ gn.getConfig = function() {
 
 function template(row, dss) {
 	row.find('.item_name').text(dss.Name);
+	row.attr('data-backend', dss.Backend);
+	if (dss.GrafanaURL) { row.attr('data-grafana', dss.GrafanaURL); }
+	$("<img class='sparkline' alt='' width='120' height='24'>")
+		.attr('src', '/spark/' + encodeURIComponent(dss.Name) + '.svg')
+		.insertAfter(row.find('.item_name'));
 	row.find('.item_date').html("<abbr class='timeago' title='"+dss.Create_date+"'>"+dss.Create_date+"</abbr>");
 	row.find('.item_options').text(dss.Params);
+	if (dss.IdPattern) {
+		// name seems to contain IDs, which means a new metric for every ID
+		$("<span class='label label-warning' title='High cardinality: this name contains IDs, see /cardinality/'></span>")
+			.text(dss.IdPattern)
+			.insertAfter(row.find('.item_options'));
+	}
+	$.each(dss.Violations || [], function(i, v) {
+		// naming rules this data source breaks, hard ones in red
+		$("<span class='label'></span>")
+			.addClass(v.Severity == 'hard' ? 'label-danger' : 'label-default')
+			.attr('title', v.Message)
+			.text(v.Rule)
+			.insertAfter(row.find('.item_options'));
+	});
 	return row;
 }
 
@@ -47,7 +69,7 @@ gn.RemoveEnabledHTML = function() {
 	}
 }
 
-gn.GraphiteImg = function(dsname, dsdate, width, derivative, editlink) {
+gn.GraphiteImg = function(dsname, dsdate, width, derivative, editlink, backend) {
 	// If derivative is 'true', encapsulate the DS with a derivative function
 	// in graphite. If none is given, determine if DS ends in '.*count', in 
 	// which case we'll turn it on anyways.
@@ -66,18 +88,62 @@ gn.GraphiteImg = function(dsname, dsdate, width, derivative, editlink) {
 	if (!editlink) { render = "render/" } else { render = "" }
 
 	tmp = "";
-	tmp = tmp + gn.GraphiteURL
+	// graphs come through graphite-news if it proxies the render API,
+	// otherwise straight from the graphite-web the data source lives in
+	var base = gn.GraphiteURL;
+	if (backend && gn.Backends && gn.Backends[backend]) { base = gn.Backends[backend] }
+	if (!gn.ProxyRender || editlink) { tmp = tmp + base }
 	tmp = tmp + "/" + render + "?width=" +Math.floor(width)
 	tmp = tmp + "&target=cactiStyle("
 	if(derivative) { tmp = tmp + "perSecond(" }
 	tmp = tmp + escape(dsname)
 	if(derivative) { tmp = tmp + ")" }
 	tmp = tmp + ",'si')"
+	if (gn.ProxyRender && !editlink && backend) { tmp = tmp + "&backend=" + encodeURIComponent(backend) }
 	tmp = tmp + "&lineMode=connected&areaAlpha=0.15&areaMode=all"
 
 	return tmp
 }
 
+// Draws the last day of a data source on a canvas, with the points
+// read from its whisper file by the server
+gn.drawData = function(canvas, dsname) {
+	$.getJSON("/data/" + encodeURIComponent(dsname) + "?from=-24h", function(data) {
+		var ctx = canvas.getContext('2d');
+		var points = $.grep(data[0].datapoints, function(p) { return p[0] !== null; });
+		ctx.font = '14px sans-serif';
+		if (points.length == 0) {
+			ctx.fillText('No data points written yet', 20, 30);
+			return;
+		}
+		var pad = 40, w = canvas.width - 2 * pad, h = canvas.height - 2 * pad;
+		var first = data[0].datapoints[0][1], last = data[0].datapoints[data[0].datapoints.length - 1][1];
+		var min = Math.min.apply(null, $.map(points, function(p) { return p[0]; }));
+		var max = Math.max.apply(null, $.map(points, function(p) { return p[0]; }));
+		if (max == min) { max = min + 1; }
+		var x = function(t) { return pad + w * (t - first) / Math.max(1, last - first); };
+		var y = function(v) { return pad + h - h * (v - min) / (max - min); };
+
+		ctx.fillStyle = '#333';
+		ctx.fillText(dsname, pad, pad / 2);
+		ctx.fillText(max.toPrecision(4), 2, pad);
+		ctx.fillText(min.toPrecision(4), 2, pad + h);
+		ctx.strokeStyle = '#337ab7';
+		ctx.beginPath();
+		$.each(data[0].datapoints, function(i, p) {
+			// leave gaps where no points were written
+			if (p[0] === null) { return; }
+			var prev = data[0].datapoints[i - 1];
+			if (i == 0 || prev[0] === null) {
+				ctx.moveTo(x(p[1]), y(p[0]));
+			} else {
+				ctx.lineTo(x(p[1]), y(p[0]));
+			}
+		});
+		ctx.stroke();
+	});
+}
+
 // Update the set of data sources in the table, filter out
 // the ones that we already have based on DS name.
 gn.updateDs = function() {
@@ -86,6 +152,9 @@ gn.updateDs = function() {
 	})
 	.done(function() {
 		gn.serverActive();
+		if ($('#dstree').is(':visible')) {
+			gn.updateTree();
+		}
 		data = jqxhr.responseJSON;
 		$("#dscount").text(data.length);
 		$.map(data, function(el) {
@@ -123,17 +192,28 @@ gn.updateDs = function() {
 				var tmp = $('#cart .templateds').clone()
 				.removeClass('templateds')
 				.addClass('timeseries');
-				tmp.find('td:first')
-				.html(
-					"<div class='timeseriescontainer'>"
-					+"  <img class='img-rounded' src=\""+
+				// without graphite-web we draw the graph ourselves from /data/
+				var graph = "  <img class='img-rounded' src=\""+
 						gn.GraphiteImg(
 							$(this).find("td:first").text(),
 					"none",
-					Math.floor($(this).width() * 0.9))+"\">"
+					Math.floor($(this).width() * 0.9),
+					undefined, false, $(this).attr('data-backend'))+"\">";
+				if (gn.LocalRender) {
+					graph = "  <canvas class='img-rounded' width='"+Math.floor($(this).width() * 0.9)+"' height='300'></canvas>";
+				}
+				var grafana = '';
+				if ($(this).attr('data-grafana')) {
+					grafana = '    <a href="'+$(this).attr('data-grafana')+'" type="button" class="btn btn-default">Grafana</a>';
+				}
+				tmp.find('td:first')
+				.html(
+					"<div class='timeseriescontainer'>"
+					+ graph
 					+ '<span class="tsbtntoolbar">'
 					+ '  <div class="btn-group btn-group-sm">'
-					+ '    <a href="'+gn.GraphiteImg($(this).find("td:first").text(),"none",undefined,undefined,true)+'" type="button" class="btn btn-default">Edit</button>'
+					+ '    <a href="'+gn.GraphiteImg($(this).find("td:first").text(),"none",undefined,undefined,true,$(this).attr('data-backend'))+'" type="button" class="btn btn-default'+ (gn.LocalRender ? ' disabled' : '') +'">Edit</button>'
+					+ grafana
 					+ '    <a id="btnRemove" href="" type="button" class="btn btn-default'+ gn.RemoveEnabledHTML() +'">Remove</button>'
 					+ '  <div>'
 					+ '</span>'
@@ -142,6 +222,9 @@ gn.updateDs = function() {
 				.end()
 				.click( function(){ $(this).remove() })
 				.insertAfter('#cart .gnhover').fadeIn();
+				if (gn.LocalRender) {
+					gn.drawData(tmp.find('canvas')[0], $(this).find("td:first").text());
+				}
 
 				// if we allow deletes, attach a handler
 				if (gn.AllowDsDeletes) {
@@ -186,6 +269,69 @@ gn.updateDs = function() {
 	})
 }
 
+// Histogram of creates per hour, shown above the list
+gn.updateTrends = function() {
+	$.getJSON("/trends/?bucket=hour", function(data) {
+		var max = 1;
+		$.each(data.Buckets, function(i, b) { max = Math.max(max, b.Count); });
+		var trends = $('#trends').empty();
+		$.each(data.Buckets, function(i, b) {
+			$("<div class='bar'></div>")
+				.css('height', Math.round(100 * b.Count / max) + '%')
+				.attr('title', b.Count + ' created at ' + new Date(b.Time).toLocaleString())
+				.appendTo(trends);
+		});
+	});
+}
+
+// Tree view: the new data sources rolled up on their name segments, so
+// hundreds of siblings collapse into one line with a count.
+gn.treeOpen = {};
+gn.treeNode = function(node) {
+	var li = $("<li></li>");
+	var toggle = $("<span class='tree_toggle'></span>").appendTo(li);
+	$("<span class='tree_name'></span>").text(node.Name).appendTo(li);
+	$("<span class='badge'></span>").text(node.Count).appendTo(li);
+	$("<abbr class='timeago'></abbr>").attr('title', node.Create_date).text(node.Create_date).appendTo(li);
+
+	if (node.Children) {
+		var ul = $("<ul></ul>").appendTo(li);
+		$.each(node.Children, function(i, child) {
+			gn.treeNode(child).appendTo(ul);
+		});
+		// remember which nodes are open, the tree gets redrawn on every update
+		var open = gn.treeOpen[node.Path] === true;
+		ul.toggle(open);
+		toggle.text(open ? '\u25BE' : '\u25B8');
+		li.children('.tree_toggle, .tree_name').click(function() {
+			gn.treeOpen[node.Path] = !gn.treeOpen[node.Path];
+			ul.toggle(gn.treeOpen[node.Path]);
+			toggle.text(gn.treeOpen[node.Path] ? '\u25BE' : '\u25B8');
+			return false;
+		});
+	}
+	return li;
+}
+
+gn.updateTree = function() {
+	$.getJSON("/tree/?history=1", function(root) {
+		var tree = $('#dstree .tree').empty();
+		$.each(root.Children || [], function(i, child) {
+			gn.treeNode(child).appendTo(tree);
+		});
+		jQuery("#dstree abbr.timeago").timeago();
+	});
+}
+
+gn.toggleTree = function() {
+	$('#dslist').toggle();
+	$('#dstree').toggle();
+	$('#treeButton').text($('#dstree').is(':visible') ? 'List' : 'Tree');
+	if ($('#dstree').is(':visible')) {
+		gn.updateTree();
+	}
+}
+
 // functions to signal the status of connectivity to backend server
 gn.serverActive = function() {
 	$("#servercon").addClass('label-success');
@@ -234,7 +380,13 @@ $(document).ready(function() {
 		$("#hideButton").click( function() {
 			gn.toggle();
 		});
+		$("#treeButton").click( function() {
+			gn.toggleTree();
+			return false;
+		});
 		gn.updateDs()
+		gn.updateTrends()
 		setInterval(function() {gn.getConfig();}, /* 1 minute */ 1*60*1000);
+		setInterval(function() {gn.updateTrends();}, /* 1 minute */ 1*60*1000);
 	}
 });
@@ -0,0 +1,124 @@
+package main
+
+// Several Graphite clusters, each with their own graphite-web. Backends are
+// configured in a JSON file (-gb) and every data source is routed to the
+// first backend matching where it came from or its name:
+//
+//	[{"Name": "eu", "URL": "http://graphite-eu:8080", "Logfiles": ["/var/log/carbon-eu/*"]},
+//	 {"Name": "us", "URL": "http://graphite-us:8080", "Hosts": ["carbon-us-*"], "Prefixes": ["us"]}]
+//
+// Data sources no backend claims go to the default one, -s. The name of the
+// backend ends up in the data source (Backend), the UI finds its URL in
+// /config/.
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"path/filepath"
+)
+
+// A graphite-web and the data sources it has
+type graphiteBackend struct {
+	Name      string
+	URL       string   // of the render API, no trailing slash
+	Logfiles  []string // globs of logfiles (-l) whose data sources it has
+	Hosts     []string // globs of origin hosts
+	Instances []string // globs of carbon instances
+	Upstreams []string // graphite-news upstreams (-u)
+	Prefixes  []string // graphite style globs of names, see matchesPrefix
+
+	GrafanaUID string // of the Grafana data source for this backend, see grafana.go
+}
+
+// Name of the backend behind -s
+const defaultBackend = "default"
+
+// Backends in use, set once at startup
+var backends []graphiteBackend
+
+func loadBackends(file string) ([]graphiteBackend, error) {
+	var list []graphiteBackend
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("Could not parse %v: %v", file, err)
+	}
+	seen := map[string]bool{defaultBackend: true}
+	for _, b := range list {
+		if len(b.Name) == 0 || len(b.URL) == 0 {
+			return nil, fmt.Errorf("Every backend in %v needs a Name and URL", file)
+		}
+		if seen[b.Name] {
+			return nil, fmt.Errorf("Backend %v in %v is not unique", b.Name, file)
+		}
+		seen[b.Name] = true
+	}
+	return list, nil
+}
+
+// Returns true if any of the globs match s
+func matchesAnyGlob(globs []string, s string) bool {
+	for _, g := range globs {
+		if ok, _ := filepath.Match(g, s); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (b graphiteBackend) match(ds Datasource) bool {
+	return matchesAnyGlob(b.Logfiles, ds.Logfile) ||
+		matchesAnyGlob(b.Hosts, ds.Host) ||
+		matchesAnyGlob(b.Instances, ds.Instance) ||
+		containsString(b.Upstreams, ds.Upstream) && len(ds.Upstream) > 0 ||
+		len(b.Prefixes) > 0 && matchesAnyPrefix(b.Prefixes, ds.Name)
+}
+
+// Name of the backend a data source should be rendered from
+func backendFor(ds Datasource) string {
+	for _, b := range backends {
+		if b.match(ds) {
+			return b.Name
+		}
+	}
+	return defaultBackend
+}
+
+// URL of a backend by name, "" if there is no such backend
+func backendURL(name string) string {
+	if len(name) == 0 || name == defaultBackend {
+		return C.GraphiteURL
+	}
+	for _, b := range backends {
+		if b.Name == name {
+			return b.URL
+		}
+	}
+	return ""
+}
+
+// URL of the graphite-web to render a data source from
+func graphiteURLFor(ds Datasource) string {
+	if u := backendURL(ds.Backend); len(u) > 0 {
+		return u
+	}
+	return C.GraphiteURL
+}
+
+// Link to the graph of a data source in its graphite-web
+func dsGraphURL(ds Datasource) string {
+	return graphiteURLFor(ds) + "/?target=" + url.QueryEscape(ds.Name)
+}
+
+// All backends by name, for the UI
+func backendURLs() map[string]string {
+	urls := map[string]string{defaultBackend: C.GraphiteURL}
+	for _, b := range backends {
+		urls[b.Name] = b.URL
+	}
+	return urls
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestBackendRouting(t *testing.T) {
+	file, _ := ioutil.TempFile("", "graphite-news-backends")
+	defer os.Remove(file.Name())
+	file.WriteString(`[
+		{"Name": "eu", "URL": "http://graphite-eu:8080", "Logfiles": ["/var/log/carbon-eu/*"]},
+		{"Name": "us", "URL": "http://graphite-us:8080", "Hosts": ["carbon-us-*"], "Prefixes": ["us"]},
+		{"Name": "remote", "URL": "http://graphite-remote:8080", "Upstreams": ["http://news-remote:2934"]}
+	]`)
+	file.Close()
+
+	var err error
+	if backends, err = loadBackends(file.Name()); err != nil {
+		t.Fatal(fmt.Sprintf("Could not load backends: %v", err))
+	}
+	defer func() { backends = nil }()
+
+	var testCases = []struct {
+		ds      Datasource
+		backend string
+	}{
+		{Datasource{Name: "app.count", Origin: Origin{Logfile: "/var/log/carbon-eu/creates.log"}}, "eu"},
+		{Datasource{Name: "app.count", Origin: Origin{Host: "carbon-us-3"}}, "us"},
+		{Datasource{Name: "us.app.count"}, "us"},
+		{Datasource{Name: "app.count", Upstream: "http://news-remote:2934"}, "remote"},
+		{Datasource{Name: "app.count", Origin: Origin{Logfile: "/var/log/carbon/creates.log"}}, "default"},
+	}
+	for _, test := range testCases {
+		if b := backendFor(test.ds); b != test.backend {
+			t.Fatal(fmt.Sprintf("Expected %+v to go to backend %v, got %v", test.ds, test.backend, b))
+		}
+	}
+
+	if u := graphiteURLFor(Datasource{Backend: "us"}); u != "http://graphite-us:8080" {
+		t.Fatal(fmt.Sprintf("Unexpected URL for backend us: %v", u))
+	}
+	if u := graphiteURLFor(Datasource{Backend: "gone"}); u != C.GraphiteURL {
+		t.Fatal(fmt.Sprintf("Expected unknown backend to fall back to -s, got %v", u))
+	}
+	if urls := backendURLs(); len(urls) != 4 || urls["default"] != C.GraphiteURL {
+		t.Fatal(fmt.Sprintf("Unexpected backend URLs: %v", urls))
+	}
+
+	State.Vals = nil // start fresh
+	defer func() { State.Vals = nil }()
+	addItemToState(Datasource{Name: "us.app.latency"})
+	if ds := getDSbyName("us.app.latency"); ds.Backend != "us" {
+		t.Fatal(fmt.Sprintf("Expected data source to be routed to us, got %+v", ds))
+	}
+
+	for _, config := range []string{`[{"Name": "x"}]`, `[{"Name": "default", "URL": "http://x"}]`, `[{"Name": "x", "URL": "http://x"}, {"Name": "x", "URL": "http://y"}]`} {
+		ioutil.WriteFile(file.Name(), []byte(config), 0644)
+		if _, err := loadBackends(file.Name()); err == nil {
+			t.Fatal(fmt.Sprintf("Invalid backends were accepted: %v", config))
+		}
+	}
+}
@@ -45,84 +45,95 @@ func assets_favicon_ico() ([]byte, error) {
 func assets_index_html() ([]byte, error) {
 	return bindata_read([]byte{
 		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x00, 0xff, 0xb4, 0x56,
-		0x4b, 0x6f, 0xe3, 0x36, 0x10, 0x3e, 0x27, 0xbf, 0x82, 0x65, 0x0e, 0xb9,
-		0x54, 0x52, 0x83, 0xde, 0x52, 0x59, 0x87, 0x6d, 0x81, 0x02, 0x3d, 0xec,
-		0xa2, 0x0f, 0xa0, 0xc7, 0x82, 0x12, 0x27, 0x12, 0x5d, 0x9a, 0x54, 0xc9,
-		0x51, 0x1c, 0xff, 0xfb, 0x0e, 0x1f, 0xb2, 0x64, 0x39, 0xbb, 0xcd, 0x22,
-		0x28, 0x10, 0x47, 0xd2, 0x68, 0xde, 0xdf, 0x37, 0x23, 0xd6, 0x03, 0x1e,
-		0x74, 0x73, 0x5b, 0x0f, 0x20, 0x64, 0x73, 0xcb, 0x58, 0x8d, 0x0a, 0x35,
-		0x34, 0x3f, 0x3b, 0x31, 0x0e, 0x0a, 0x81, 0x7d, 0x84, 0xa3, 0xaf, 0xab,
-		0x24, 0x0c, 0xaf, 0xb5, 0x32, 0x7f, 0x33, 0x3c, 0x8d, 0xb0, 0xe3, 0x08,
-		0x2f, 0x58, 0x75, 0xde, 0x73, 0xe6, 0x40, 0xef, 0xb8, 0xc7, 0x93, 0x06,
-		0x3f, 0x00, 0x20, 0x67, 0x83, 0x83, 0xa7, 0x1d, 0xaf, 0x84, 0xf7, 0x80,
-		0x3e, 0xe8, 0x54, 0xbd, 0x29, 0x83, 0xea, 0xe2, 0xe3, 0x0d, 0x36, 0xad,
-		0xb5, 0xe8, 0x91, 0x32, 0x29, 0x0f, 0x6a, 0x36, 0x0f, 0xf6, 0xbe, 0x73,
-		0x6a, 0xc4, 0x94, 0xc5, 0x7d, 0xcc, 0x62, 0x2f, 0x9e, 0x45, 0x92, 0xde,
-		0x33, 0xef, 0xba, 0xdd, 0xfd, 0xec, 0x66, 0x4f, 0x7f, 0xff, 0x4c, 0xe0,
-		0x4e, 0xc5, 0x43, 0xf9, 0x40, 0x7f, 0xe5, 0xde, 0xdf, 0x37, 0x75, 0x95,
-		0x74, 0x9b, 0xaf, 0x77, 0x76, 0x4e, 0xa9, 0xe8, 0x9d, 0x3d, 0xea, 0xf7,
-		0xba, 0x4b, 0xb9, 0x95, 0xa8, 0x0e, 0x20, 0x7a, 0xfb, 0x59, 0x6f, 0xc1,
-		0x8c, 0xbf, 0x96, 0x45, 0x6c, 0xcc, 0x9e, 0xfa, 0xf2, 0x8e, 0x1c, 0xfa,
-		0x0c, 0x75, 0x61, 0x08, 0xea, 0x4d, 0x0a, 0x75, 0x95, 0x78, 0x51, 0xb7,
-		0x56, 0x9e, 0xa8, 0xf9, 0xb5, 0x11, 0xcf, 0xac, 0xd3, 0x64, 0xbc, 0xe3,
-		0x74, 0xdb, 0x0a, 0xc7, 0xd2, 0xa5, 0x90, 0xf0, 0x24, 0x26, 0x8d, 0xf3,
-		0xe3, 0x93, 0x7a, 0x01, 0x59, 0xa0, 0x1d, 0x67, 0x81, 0x32, 0xcf, 0xe0,
-		0x3c, 0x10, 0x57, 0xac, 0x86, 0x68, 0xac, 0x7a, 0x81, 0xca, 0x9a, 0x44,
-		0x09, 0xa9, 0xce, 0x7e, 0x3b, 0x6b, 0x50, 0x28, 0x03, 0xe4, 0x44, 0x4f,
-		0x4a, 0xc6, 0xf7, 0xa4, 0xf1, 0x4d, 0x51, 0xb0, 0x0f, 0x4e, 0x18, 0xc9,
-		0xc2, 0x0f, 0x6d, 0xdf, 0x6b, 0x60, 0x3d, 0x20, 0x23, 0x20, 0xa6, 0x11,
-		0x24, 0x7b, 0xb2, 0x8e, 0xb5, 0x80, 0x08, 0x8e, 0x1d, 0x6c, 0xab, 0xe8,
-		0xad, 0x54, 0x7e, 0xd4, 0xe2, 0xc4, 0x8a, 0x22, 0xfb, 0x58, 0x45, 0xc9,
-		0x69, 0x85, 0xf2, 0xc0, 0xe5, 0x18, 0xa4, 0xd1, 0x4e, 0x88, 0xd6, 0x64,
-		0x82, 0xa7, 0x07, 0xbe, 0x31, 0xc9, 0xa1, 0x3b, 0xab, 0xb5, 0x18, 0x3d,
-		0x48, 0xce, 0xa4, 0x40, 0x91, 0xc5, 0x21, 0xfd, 0x24, 0x9f, 0xc5, 0xc2,
-		0x51, 0x92, 0x3b, 0x7e, 0xd7, 0xfa, 0x02, 0x5e, 0xc4, 0x61, 0xd4, 0xd4,
-		0xe8, 0xe4, 0x68, 0xd6, 0x2c, 0x1e, 0xce, 0xf1, 0x03, 0x78, 0xa3, 0x30,
-		0x73, 0x44, 0xef, 0x0a, 0x6b, 0xf4, 0x89, 0x37, 0x7f, 0xa4, 0x98, 0x4b,
-		0xd7, 0x08, 0x22, 0xd2, 0xfb, 0x8c, 0x99, 0xa2, 0x16, 0x16, 0x14, 0x21,
-		0xd2, 0xe2, 0x7f, 0x53, 0xab, 0xab, 0xd4, 0x9f, 0xf3, 0xb3, 0xd8, 0x34,
-		0xaa, 0x0d, 0x68, 0xcd, 0x73, 0x7d, 0xc7, 0xb7, 0x3b, 0x45, 0x64, 0x50,
-		0x2a, 0x42, 0x25, 0xce, 0x75, 0x06, 0xf9, 0x47, 0x6a, 0x0b, 0x74, 0x44,
-		0xdf, 0x21, 0x16, 0xcc, 0xc2, 0xb6, 0xf0, 0xdf, 0x06, 0x78, 0x0f, 0x74,
-		0x09, 0xe0, 0x5b, 0x7a, 0xe5, 0x58, 0xe0, 0x09, 0x18, 0x8c, 0xb8, 0xc7,
-		0xe6, 0x2b, 0xd3, 0xbf, 0x0a, 0xf5, 0xdc, 0x67, 0xb6, 0xe9, 0x3b, 0x67,
-		0x4a, 0x12, 0xc8, 0x6f, 0xc1, 0xa5, 0x9e, 0xf4, 0xaa, 0xb8, 0xd9, 0x11,
-		0x5d, 0x48, 0xe3, 0x86, 0xf6, 0x59, 0x43, 0xc5, 0xa7, 0x3a, 0xa9, 0x4b,
-		0xb1, 0x79, 0xc1, 0xb5, 0x07, 0x47, 0xa4, 0xef, 0x56, 0x14, 0xd2, 0xa2,
-		0x05, 0xcd, 0xe2, 0xff, 0x42, 0x0a, 0xd3, 0x53, 0x19, 0xe9, 0x21, 0xba,
-		0xfa, 0x3d, 0xea, 0x53, 0x03, 0x8c, 0xa1, 0x06, 0x2c, 0x28, 0x87, 0x5e,
-		0xd5, 0x15, 0x45, 0x59, 0x00, 0x5a, 0x87, 0x1c, 0x10, 0x47, 0xff, 0x58,
-		0x55, 0xbd, 0xc2, 0x61, 0x6a, 0xcb, 0xce, 0x1e, 0x2a, 0xbb, 0x57, 0xd4,
-		0xc4, 0xcd, 0x6c, 0x57, 0x04, 0x41, 0x54, 0x61, 0xbf, 0xc1, 0x68, 0x17,
-		0xa7, 0x37, 0x75, 0x35, 0xe9, 0x8c, 0xc0, 0x17, 0x4a, 0x9d, 0x6f, 0x9d,
-		0xea, 0x07, 0xbc, 0xaa, 0xfb, 0x6e, 0x5d, 0x78, 0x6f, 0x8a, 0x30, 0xed,
-		0x71, 0xba, 0xaf, 0x6a, 0xb8, 0xb2, 0xfb, 0x89, 0xe6, 0xc4, 0xdb, 0xc9,
-		0x75, 0xe0, 0x1f, 0xd9, 0xe2, 0x44, 0xfa, 0xce, 0x4e, 0x06, 0xcf, 0xbd,
-		0x6b, 0x85, 0xec, 0x81, 0x37, 0xdf, 0x5d, 0x3b, 0x64, 0x75, 0x20, 0xc7,
-		0x86, 0x7d, 0x51, 0xb4, 0x4d, 0x39, 0x77, 0xee, 0xd5, 0x29, 0x0f, 0x31,
-		0x07, 0x25, 0xe1, 0xc3, 0xe5, 0xd4, 0xb7, 0x68, 0x18, 0xfd, 0x0a, 0x3f,
-		0x75, 0x94, 0xa0, 0x67, 0xd2, 0xd9, 0x51, 0xda, 0xa3, 0xc9, 0x03, 0xcf,
-		0x59, 0x74, 0xcb, 0xd8, 0x9f, 0x4e, 0xd1, 0xee, 0x21, 0xdd, 0x13, 0xfb,
-		0xa5, 0x64, 0x9f, 0xb4, 0x04, 0xd3, 0xc2, 0xf4, 0x1c, 0x48, 0x79, 0x81,
-		0x14, 0x01, 0x75, 0x3c, 0x1e, 0xcb, 0x04, 0x50, 0x69, 0xe8, 0xcb, 0xd7,
-		0x04, 0x86, 0xc7, 0x79, 0xb8, 0x59, 0x4d, 0xd5, 0x19, 0x9b, 0x50, 0x48,
-		0xee, 0x5a, 0x59, 0x96, 0x6b, 0x1e, 0x24, 0xdc, 0x96, 0x21, 0x8a, 0xe3,
-		0x53, 0x95, 0x1b, 0x1a, 0xe7, 0x99, 0xb8, 0x50, 0xd9, 0xec, 0xd8, 0xa8,
-		0x52, 0x57, 0x64, 0x48, 0x34, 0xb8, 0x8d, 0xb3, 0x93, 0x10, 0xd0, 0xca,
-		0x63, 0x5a, 0xd0, 0x28, 0x5a, 0x5a, 0x40, 0x41, 0xda, 0x09, 0xb7, 0x80,
-		0x12, 0xc5, 0xf3, 0x8a, 0xc6, 0xf9, 0x10, 0x91, 0x9e, 0xdc, 0x8a, 0xaf,
-		0x38, 0x34, 0x1f, 0xc5, 0x01, 0xe8, 0x24, 0x31, 0x5c, 0x4a, 0x09, 0xfc,
-		0x57, 0xa4, 0x9f, 0xc6, 0x40, 0x7f, 0xbf, 0x7e, 0x41, 0xf7, 0x6e, 0x2e,
-		0x76, 0x15, 0x88, 0xc2, 0x9c, 0x73, 0x01, 0x9a, 0x62, 0x72, 0xc7, 0x59,
-		0x3c, 0x56, 0x50, 0xfe, 0x69, 0xff, 0x3f, 0x1a, 0x6b, 0xe0, 0x87, 0x65,
-		0x96, 0x51, 0x36, 0x97, 0x0b, 0x8e, 0x0c, 0xff, 0x32, 0x94, 0x1d, 0xcf,
-		0x39, 0x66, 0x7e, 0xa1, 0xfc, 0xb2, 0x89, 0x0c, 0xb1, 0x9a, 0x5f, 0x27,
-		0x61, 0xe8, 0x78, 0x74, 0x7a, 0xb3, 0x99, 0x4d, 0xb5, 0x85, 0xa5, 0x8e,
-		0x42, 0x5f, 0x99, 0xad, 0xea, 0xbc, 0x2e, 0x4d, 0xfa, 0xff, 0x2e, 0x2e,
-		0x7c, 0x99, 0x82, 0xcf, 0x1d, 0xff, 0x9e, 0x7f, 0x5d, 0xa1, 0x73, 0x68,
-		0xba, 0x06, 0x58, 0x03, 0x25, 0xd2, 0x6e, 0x26, 0x52, 0xc6, 0xef, 0x3f,
-		0x1d, 0x07, 0xe2, 0x69, 0xf1, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xaf,
-		0xdf, 0xd6, 0xf2, 0x35, 0x0a, 0x00, 0x00,
+		0x4d, 0x6f, 0xdc, 0x36, 0x13, 0x3e, 0x67, 0x7f, 0xc5, 0xbc, 0xf4, 0xc1,
+		0x87, 0x37, 0x12, 0x6b, 0xb4, 0x87, 0xc2, 0xe5, 0xea, 0x10, 0x07, 0x08,
+		0xd0, 0x43, 0x82, 0xb6, 0x01, 0x7a, 0x2c, 0x46, 0xe2, 0x58, 0xa2, 0x4b,
+		0x91, 0x2a, 0x39, 0xda, 0xf5, 0xfe, 0xfb, 0x82, 0x94, 0xb4, 0x2b, 0xaf,
+		0x37, 0xb1, 0x83, 0xa0, 0x30, 0x6c, 0x8b, 0xa3, 0x99, 0x67, 0x66, 0x9e,
+		0xf9, 0x10, 0x55, 0xc7, 0xbd, 0xad, 0x36, 0xaa, 0x23, 0xd4, 0xd5, 0x06,
+		0x40, 0xb1, 0x61, 0x4b, 0xd5, 0x87, 0x80, 0x43, 0x67, 0x98, 0xe0, 0x23,
+		0xed, 0xa3, 0x92, 0x93, 0x30, 0xbd, 0xb6, 0xc6, 0xfd, 0x0d, 0x7c, 0x18,
+		0x68, 0x2b, 0x98, 0x1e, 0x59, 0x36, 0x31, 0x0a, 0x08, 0x64, 0xb7, 0x22,
+		0xf2, 0xc1, 0x52, 0xec, 0x88, 0x58, 0x40, 0x17, 0xe8, 0x7e, 0x2b, 0x24,
+		0xc6, 0x48, 0x1c, 0x93, 0x8e, 0x6c, 0x5d, 0x99, 0x54, 0x4f, 0x18, 0xaf,
+		0xb0, 0xa9, 0xbd, 0xe7, 0xc8, 0x01, 0x87, 0xb2, 0x37, 0x17, 0xcd, 0xd1,
+		0x32, 0x05, 0x87, 0x4c, 0x62, 0x0e, 0x09, 0x87, 0xc1, 0x9a, 0x06, 0xd9,
+		0x78, 0x27, 0x91, 0x7d, 0xff, 0xff, 0xc7, 0xde, 0x0a, 0xc8, 0xd1, 0x6f,
+		0xc5, 0x93, 0x9c, 0x8e, 0xfe, 0xee, 0x89, 0x74, 0x99, 0x74, 0xbf, 0x09,
+		0x3c, 0xc4, 0xf8, 0x5a, 0xec, 0x90, 0xd3, 0x4e, 0xd8, 0xb1, 0x09, 0x66,
+		0xe0, 0x09, 0xed, 0x3a, 0xb3, 0xf7, 0x80, 0x3b, 0x9c, 0xa4, 0xd7, 0x10,
+		0x43, 0xb3, 0xbd, 0x5e, 0xd2, 0x7f, 0x88, 0xf2, 0xe1, 0x9f, 0x91, 0xc2,
+		0xa1, 0xb8, 0x29, 0x6f, 0x6e, 0xca, 0x9b, 0xf2, 0x21, 0x5e, 0x57, 0x4a,
+		0x4e, 0xba, 0xd5, 0xb7, 0x83, 0x1d, 0xa9, 0x2c, 0xda, 0xe0, 0xf7, 0xf6,
+		0x7b, 0xe1, 0xa6, 0xd8, 0x4a, 0x36, 0x3d, 0x61, 0xeb, 0xbf, 0x88, 0x96,
+		0xcc, 0xc4, 0xa5, 0x28, 0x72, 0x41, 0x1f, 0xa2, 0xf8, 0x9e, 0x18, 0xda,
+		0x99, 0xf2, 0xc2, 0xd1, 0x3e, 0x9e, 0x85, 0xa0, 0xe4, 0xd4, 0xcf, 0xaa,
+		0xf6, 0xfa, 0x50, 0x6d, 0x36, 0xca, 0xe1, 0x0e, 0x1a, 0x8b, 0x31, 0x6e,
+		0x85, 0xc3, 0x5d, 0x8d, 0x01, 0xa6, 0x7f, 0x85, 0xa6, 0x7b, 0x1c, 0x2d,
+		0x2f, 0xc7, 0x7b, 0xf3, 0x48, 0xba, 0x60, 0x3f, 0x2c, 0x02, 0xe3, 0x76,
+		0x14, 0x22, 0x09, 0x08, 0xde, 0x52, 0x36, 0x36, 0x6d, 0xee, 0xaf, 0xa9,
+		0x5d, 0xb4, 0x39, 0xe2, 0x36, 0xde, 0x31, 0x1a, 0x47, 0xa1, 0xb8, 0xb7,
+		0xa3, 0xd1, 0xf9, 0x3d, 0x80, 0xfa, 0x5f, 0x51, 0xc0, 0xbb, 0x80, 0x4e,
+		0x43, 0xfa, 0x65, 0xdf, 0xb6, 0x96, 0xa0, 0x25, 0x86, 0x36, 0xf8, 0x71,
+		0x20, 0x0d, 0xf7, 0x3e, 0x40, 0x4d, 0xcc, 0x14, 0xa0, 0xf7, 0xb5, 0xb1,
+		0x04, 0xda, 0xc4, 0xc1, 0xe2, 0x01, 0x8a, 0x62, 0xc6, 0x58, 0x79, 0x99,
+		0xc3, 0x4a, 0xe9, 0x51, 0x98, 0x7d, 0x00, 0xa8, 0x7a, 0x64, 0xf6, 0x6e,
+		0x6e, 0xd4, 0xe9, 0x20, 0xce, 0x4c, 0x66, 0xd7, 0x8d, 0xb7, 0x16, 0x87,
+		0x48, 0x5a, 0x80, 0x46, 0xc6, 0x59, 0xbc, 0x15, 0x8b, 0x7c, 0x11, 0x63,
+		0x68, 0x89, 0xb7, 0xe2, 0xaa, 0x8e, 0x05, 0x3d, 0x62, 0x3f, 0x58, 0x2a,
+		0x66, 0xa0, 0x45, 0xb3, 0xb8, 0x39, 0xfa, 0x4f, 0xfd, 0x38, 0xa0, 0x5b,
+		0x3c, 0xc6, 0x50, 0x78, 0x67, 0x0f, 0xa2, 0xfa, 0x9c, 0xc1, 0xe1, 0xc4,
+		0x9a, 0x92, 0x71, 0x40, 0xf7, 0x05, 0x33, 0xd3, 0x78, 0x57, 0xd4, 0x18,
+		0x44, 0xf5, 0x5f, 0xaa, 0x29, 0x39, 0xf1, 0x73, 0x3c, 0xe3, 0x19, 0x51,
+		0x75, 0xaa, 0xd6, 0x32, 0xc3, 0x57, 0xe2, 0x7c, 0x17, 0xe2, 0x5c, 0x14,
+		0xa9, 0xcd, 0xae, 0xda, 0x9c, 0x8a, 0x7c, 0xe7, 0xad, 0xa5, 0x86, 0x81,
+		0xbb, 0x9c, 0x30, 0xa4, 0x4d, 0x12, 0xdf, 0xa6, 0xf2, 0xf6, 0xf1, 0x6d,
+		0x2e, 0xbe, 0xe7, 0x8e, 0x02, 0xa4, 0x3e, 0x21, 0xc7, 0xe9, 0x05, 0x64,
+		0xf2, 0x8d, 0x6b, 0x2f, 0x96, 0x7a, 0xe1, 0x19, 0xce, 0x78, 0x17, 0x60,
+		0xf4, 0x56, 0xbc, 0xaa, 0x2e, 0x6a, 0xb4, 0xab, 0xe4, 0x16, 0x20, 0x87,
+		0x3b, 0x51, 0x6d, 0xde, 0x28, 0x6b, 0x2a, 0x85, 0x73, 0x9e, 0xa2, 0x9a,
+		0x38, 0x4e, 0xd0, 0x91, 0xc2, 0x8e, 0x42, 0xb3, 0x6a, 0x21, 0x8b, 0x35,
+		0x59, 0xc8, 0x7f, 0x0b, 0x8d, 0xae, 0xa5, 0x30, 0x1f, 0x32, 0xd4, 0x1f,
+		0x59, 0x1f, 0xee, 0xbc, 0x73, 0xd4, 0xac, 0xaa, 0x9c, 0xb8, 0x52, 0xd2,
+		0x9a, 0x25, 0x1a, 0x80, 0x27, 0x2e, 0x3b, 0xe6, 0x21, 0xde, 0x4a, 0xd9,
+		0x1a, 0xee, 0xc6, 0xba, 0x6c, 0x7c, 0x2f, 0xfd, 0x83, 0xb1, 0x96, 0xce,
+		0x66, 0x5b, 0x8a, 0xea, 0x43, 0x56, 0x81, 0xdf, 0x69, 0xf0, 0x2f, 0x80,
+		0xca, 0x06, 0x83, 0x36, 0x0e, 0xad, 0xe1, 0x83, 0x14, 0xd5, 0xdd, 0xe9,
+		0xf4, 0x82, 0xe1, 0xd5, 0x44, 0x2b, 0x07, 0xa2, 0x77, 0xb9, 0x3f, 0x44,
+		0xf5, 0x39, 0x10, 0x9d, 0xac, 0xde, 0x28, 0x39, 0xda, 0x6a, 0xf3, 0x12,
+		0xb3, 0xcb, 0x63, 0x30, 0x6d, 0xc7, 0xcf, 0x68, 0xbe, 0x5a, 0xf3, 0xdc,
+		0xba, 0x22, 0x2d, 0x97, 0xbc, 0x4c, 0x9e, 0x51, 0xf6, 0xcc, 0xee, 0x3d,
+		0x32, 0x46, 0x3f, 0x86, 0x86, 0xe2, 0xed, 0xdc, 0xe9, 0x09, 0x44, 0xc7,
+		0xc6, 0x8f, 0x8e, 0x8f, 0xa5, 0xaa, 0x51, 0xb7, 0x24, 0xaa, 0x1f, 0x9e,
+		0x03, 0x82, 0x4a, 0xbd, 0xb8, 0x8a, 0x3a, 0x85, 0x99, 0x45, 0xe7, 0x21,
+		0xcf, 0xd4, 0x5c, 0x5c, 0x2a, 0xc9, 0x67, 0x67, 0xf4, 0x42, 0xd2, 0xd1,
+		0x2d, 0x3b, 0xa8, 0xd9, 0x15, 0x71, 0x6c, 0x1a, 0x8a, 0x11, 0x74, 0xf0,
+		0x83, 0xf6, 0x7b, 0x37, 0xef, 0x17, 0x01, 0x19, 0x16, 0xe0, 0xcf, 0x60,
+		0x98, 0xc9, 0x41, 0x7d, 0x80, 0x5f, 0x4b, 0xf8, 0x64, 0x35, 0xb9, 0x9a,
+		0xc6, 0x5d, 0x9a, 0x81, 0x63, 0xb2, 0xa9, 0x31, 0x6e, 0xa5, 0xdc, 0xef,
+		0xf7, 0xe5, 0xd4, 0x0f, 0xa5, 0x23, 0x16, 0x55, 0x1a, 0xa8, 0x54, 0x8e,
+		0xcd, 0x9b, 0xd5, 0x10, 0x2f, 0x64, 0xc9, 0x94, 0xc8, 0xcc, 0x5a, 0x59,
+		0x96, 0xeb, 0x42, 0x4f, 0x75, 0x3b, 0xcd, 0x6c, 0x5e, 0xc9, 0xb2, 0x3c,
+		0x9b, 0x9a, 0x79, 0x04, 0x9f, 0xa8, 0x9c, 0xad, 0xf4, 0xac, 0xa2, 0xa4,
+		0xc3, 0x34, 0xf7, 0x9b, 0x3c, 0xaa, 0x89, 0x0d, 0x1d, 0xad, 0x89, 0x7c,
+		0xfa, 0x1e, 0xcc, 0x6d, 0xe4, 0x74, 0x3c, 0x5e, 0x0f, 0xee, 0x02, 0x21,
+		0x53, 0x84, 0x81, 0x02, 0x74, 0x7e, 0x0c, 0xe0, 0xd3, 0xc0, 0xa4, 0x4d,
+		0x61, 0x31, 0x32, 0xfc, 0xf4, 0x73, 0x96, 0xe6, 0xef, 0x61, 0x72, 0x9f,
+		0xe2, 0x60, 0xac, 0x2d, 0xe5, 0x96, 0x6c, 0x30, 0x9c, 0xca, 0x9b, 0xc5,
+		0xf3, 0x7c, 0x2b, 0x5e, 0x6e, 0x6d, 0xe9, 0x47, 0x71, 0x58, 0x1e, 0xd3,
+		0xa1, 0xab, 0x3e, 0x62, 0x4f, 0x4a, 0x72, 0xf7, 0x54, 0xfa, 0x1e, 0xf9,
+		0x82, 0xf4, 0xd3, 0x90, 0xe6, 0x36, 0xae, 0x5f, 0x28, 0xb9, 0x00, 0x2a,
+		0xb9, 0x72, 0xa4, 0x38, 0x1c, 0x63, 0xa1, 0x7e, 0xb0, 0xf9, 0x82, 0x94,
+		0xef, 0x7e, 0x5b, 0x31, 0x7f, 0xb8, 0x6e, 0x9d, 0x77, 0xf4, 0xcb, 0x69,
+		0x09, 0xb1, 0x9e, 0x9b, 0x7e, 0xb6, 0x33, 0x4c, 0xfd, 0x5f, 0x0e, 0x7b,
+		0x12, 0x73, 0x8c, 0x73, 0xa7, 0xb2, 0xfe, 0xba, 0x89, 0x4e, 0xbe, 0xaa,
+		0xdf, 0x46, 0x74, 0x6c, 0xf8, 0xf0, 0x6a, 0x33, 0x3f, 0xe5, 0x96, 0xbe,
+		0x46, 0x8c, 0xf6, 0x99, 0xd9, 0x2a, 0xcf, 0xe7, 0xa9, 0xe9, 0xf8, 0x72,
+		0x72, 0xd0, 0x78, 0x9b, 0x30, 0xb7, 0xe2, 0x47, 0x71, 0xc1, 0xfd, 0x57,
+		0x12, 0x5d, 0x5c, 0x2b, 0x99, 0xcb, 0x5a, 0x6d, 0xe6, 0xf2, 0xaf, 0x7b,
+		0x2b, 0x2d, 0xa4, 0xaf, 0xc4, 0xb0, 0xda, 0x43, 0x59, 0xb3, 0x9a, 0xba,
+		0xfd, 0x08, 0x24, 0xa7, 0x1b, 0x90, 0x92, 0x1d, 0xf7, 0xb6, 0xda, 0xfc,
+		0x3b, 0x00, 0x8f, 0x8b, 0xdb, 0xf4, 0xef, 0x0b, 0x00, 0x00,
 	},
 		"assets/index.html",
 	)
@@ -8254,25 +8265,41 @@ func assets_static_css_bootstrap_min_css() ([]byte, error) {
 
 func assets_static_css_gn_css() ([]byte, error) {
 	return bindata_read([]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x00, 0xff, 0x74, 0x8f,
-		0xcd, 0x6a, 0xeb, 0x30, 0x10, 0x85, 0xd7, 0xf1, 0x53, 0x0c, 0x84, 0x6c,
-		0x02, 0x76, 0x7c, 0x03, 0xb9, 0x0b, 0x79, 0x9d, 0x45, 0x1f, 0x63, 0x24,
-		0x4f, 0x95, 0xa1, 0xb2, 0x46, 0x48, 0x93, 0x34, 0xa5, 0xf4, 0xdd, 0x2b,
-		0xcb, 0xbb, 0x40, 0xb5, 0x12, 0x9c, 0xef, 0xfc, 0xcc, 0xa0, 0x19, 0x63,
-		0x49, 0x98, 0x29, 0x2a, 0x7c, 0x77, 0x00, 0x92, 0xd0, 0xb1, 0x7e, 0x19,
-		0x18, 0x87, 0xf3, 0xd4, 0xfd, 0x74, 0xdd, 0x7e, 0x2e, 0x81, 0xcb, 0x26,
-		0x2e, 0x98, 0x3d, 0xc7, 0x5e, 0x25, 0x19, 0xb8, 0xd0, 0xd2, 0xf4, 0xc1,
-		0xc7, 0x9b, 0x3c, 0x28, 0x37, 0xc0, 0xa2, 0xfb, 0xf0, 0x59, 0xee, 0x71,
-		0xee, 0x9d, 0x04, 0xc9, 0x06, 0x2c, 0xb1, 0xa7, 0x0d, 0x54, 0x5e, 0xa8,
-		0x50, 0x66, 0x2a, 0x7f, 0xb0, 0xfb, 0x6b, 0x7b, 0x53, 0x15, 0x95, 0x9e,
-		0xda, 0x63, 0x60, 0x1f, 0x0d, 0xb8, 0xba, 0x8d, 0xf2, 0x6b, 0x86, 0x93,
-		0xa8, 0xc8, 0xb1, 0x15, 0xef, 0x92, 0x14, 0x56, 0x96, 0x0a, 0x67, 0x0a,
-		0xa8, 0xfc, 0xa8, 0x95, 0xbb, 0x4f, 0x9e, 0xf5, 0x66, 0xe0, 0xdf, 0x38,
-		0x1e, 0x26, 0x38, 0x1d, 0xe1, 0xed, 0xfa, 0x1f, 0x8e, 0xa7, 0x2d, 0xa6,
-		0x58, 0x8d, 0x2a, 0x12, 0x2c, 0xbe, 0xf8, 0xd1, 0x16, 0x09, 0x77, 0x5d,
-		0xfd, 0xed, 0xcc, 0xf3, 0x25, 0x3d, 0xeb, 0x3f, 0xd0, 0xbb, 0xae, 0x51,
-		0x87, 0x75, 0xc6, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x40, 0x95, 0xab,
-		0xbc, 0x35, 0x01, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x00, 0xff, 0x7c, 0x52,
+		0x4d, 0x6f, 0xdb, 0x30, 0x0c, 0x3d, 0xdb, 0xbf, 0x82, 0x40, 0xd1, 0x4b,
+		0x31, 0xb9, 0x4e, 0xb1, 0xec, 0x20, 0x9f, 0x7b, 0xd8, 0xaf, 0x28, 0x64,
+		0x8b, 0x55, 0x88, 0xc8, 0x94, 0x20, 0x31, 0x59, 0x83, 0xa1, 0xfb, 0xed,
+		0x83, 0xa4, 0xa4, 0x48, 0xba, 0x0f, 0x03, 0xb6, 0x01, 0xf1, 0xe9, 0xbd,
+		0xc7, 0x47, 0x0e, 0x92, 0x0c, 0xe7, 0x68, 0x12, 0xb2, 0xc0, 0xcf, 0x1e,
+		0x20, 0x44, 0xb3, 0x90, 0x9c, 0x34, 0x8c, 0xc3, 0xd3, 0xd4, 0xbf, 0xf7,
+		0xfd, 0x9d, 0xcd, 0x9e, 0x72, 0x2b, 0xae, 0x26, 0x39, 0x62, 0x25, 0x21,
+		0x6a, 0xd8, 0xe2, 0x5a, 0xeb, 0x83, 0xe3, 0x5d, 0x38, 0x62, 0xaa, 0x80,
+		0xd9, 0x2c, 0x7b, 0x97, 0xc2, 0x81, 0xad, 0x5a, 0x82, 0x0f, 0x49, 0xc3,
+		0x8c, 0xe4, 0xb0, 0x01, 0x85, 0x56, 0xcc, 0x98, 0x08, 0xf3, 0x3f, 0xb0,
+		0x77, 0xcf, 0xf5, 0x99, 0x7a, 0x00, 0xc1, 0x37, 0x51, 0xc6, 0x93, 0x63,
+		0x0d, 0x0b, 0xb2, 0x60, 0xfa, 0xcc, 0xb1, 0x04, 0x16, 0x43, 0x5c, 0x85,
+		0xbb, 0x18, 0x32, 0x09, 0x05, 0xd6, 0x90, 0xd0, 0x1b, 0xa1, 0x23, 0x4e,
+		0x7d, 0xf7, 0x83, 0xac, 0xec, 0x34, 0x6c, 0xc6, 0xf1, 0x7e, 0x82, 0xc7,
+		0x07, 0xf8, 0xfe, 0xfc, 0x0d, 0x1e, 0x1e, 0x1b, 0x4d, 0x9e, 0x85, 0x25,
+		0x04, 0x3f, 0x9b, 0x4f, 0xf7, 0xcd, 0x9c, 0x83, 0x3f, 0x48, 0xb9, 0x5f,
+		0xdb, 0x7c, 0xda, 0xc6, 0xb7, 0xa9, 0xef, 0x3c, 0xbe, 0x4a, 0xa1, 0xba,
+		0x6f, 0x36, 0x48, 0x70, 0x7d, 0x09, 0xb1, 0x48, 0x66, 0xf8, 0x05, 0x83,
+		0x37, 0x33, 0xfa, 0x42, 0x74, 0x4e, 0xa8, 0xc1, 0xc7, 0xe1, 0x12, 0xd2,
+		0x9d, 0xcd, 0x92, 0x10, 0xaf, 0x10, 0xb7, 0x19, 0x96, 0xe2, 0x17, 0xa8,
+		0x3f, 0x38, 0x54, 0xa2, 0x92, 0xb9, 0xca, 0x72, 0xf2, 0xa8, 0x81, 0x03,
+		0x17, 0x3f, 0xd1, 0x58, 0x4b, 0xec, 0xd4, 0xd9, 0xcb, 0x07, 0x79, 0xbb,
+		0x56, 0xbf, 0x2f, 0x12, 0x9c, 0xf3, 0x55, 0x67, 0x39, 0xa4, 0x5c, 0x06,
+		0x10, 0x03, 0xb5, 0xf8, 0x3a, 0x4b, 0x39, 0x7a, 0x73, 0xd2, 0x40, 0xec,
+		0x89, 0x51, 0xcd, 0x3e, 0x2c, 0xfb, 0xab, 0x9c, 0x6e, 0xe9, 0x66, 0x63,
+		0x1d, 0xfe, 0xaf, 0x25, 0x49, 0xc8, 0xb6, 0x8c, 0xb2, 0xdb, 0x21, 0xb9,
+		0x9d, 0x68, 0xf8, 0x3a, 0xd6, 0xac, 0x5a, 0x87, 0x1a, 0x46, 0xd8, 0xe0,
+		0x7a, 0x79, 0xaf, 0xf5, 0x5f, 0x3d, 0x16, 0x5c, 0x1d, 0xaf, 0x2a, 0x51,
+		0xe6, 0x76, 0xa6, 0x90, 0xed, 0x0d, 0xf7, 0x70, 0x1e, 0x4f, 0x29, 0x6a,
+		0xd8, 0x7c, 0x70, 0xab, 0xd4, 0x04, 0x37, 0x4d, 0x8f, 0x58, 0x5d, 0x2c,
+		0xb4, 0x93, 0xbf, 0xac, 0xd6, 0x76, 0x5e, 0x46, 0x7b, 0x5e, 0xc4, 0xb2,
+		0xef, 0xfb, 0x12, 0xc1, 0x1f, 0xed, 0x35, 0xa3, 0x47, 0x4c, 0x42, 0x8b,
+		0xf1, 0x97, 0x05, 0x5c, 0xc9, 0x5a, 0x8f, 0x53, 0xff, 0xde, 0xff, 0x1e,
+		0x00, 0x08, 0x3c, 0xc6, 0x10, 0x2f, 0x03, 0x00, 0x00,
 	},
 		"assets/static/css/gn.css",
 	)
@@ -10263,243 +10290,412 @@ func assets_static_js_bootstrap_min_js() ([]byte, error) {
 
 func assets_static_js_graphite_news_js() ([]byte, error) {
 	return bindata_read([]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x00, 0xff, 0xcc, 0x59,
-		0x5d, 0x73, 0xdb, 0x36, 0xd6, 0xbe, 0xb6, 0x7e, 0x05, 0xc2, 0x66, 0x4a,
-		0xca, 0x96, 0x25, 0xe7, 0xa2, 0xef, 0xbb, 0x1b, 0x4b, 0xee, 0x78, 0xe3,
-		0x4c, 0xd7, 0x9d, 0x36, 0xc9, 0xda, 0xc9, 0xee, 0x4d, 0x67, 0x3a, 0x10,
-		0x09, 0x49, 0x48, 0x48, 0x90, 0x05, 0x40, 0x2b, 0x6a, 0xea, 0xff, 0xbe,
-		0xcf, 0xc1, 0x07, 0x45, 0x7d, 0x8c, 0xd3, 0x9d, 0xbd, 0xd9, 0x8b, 0xa6,
-		0x22, 0x70, 0xce, 0xc1, 0xf9, 0x3e, 0x0f, 0xe0, 0x07, 0xae, 0xd9, 0x52,
-		0xb1, 0x19, 0xfb, 0xf2, 0x78, 0x39, 0x18, 0x2c, 0xd5, 0xf8, 0x47, 0x53,
-		0xab, 0x77, 0x6d, 0x59, 0xde, 0x2a, 0x2b, 0xf4, 0x03, 0x2f, 0xb1, 0x75,
-		0x71, 0x49, 0x1b, 0x3f, 0x68, 0xde, 0xac, 0xa4, 0x15, 0x1f, 0xee, 0x7e,
-		0xc2, 0x5a, 0x9a, 0xba, 0xc5, 0xa5, 0xb0, 0xaf, 0x6a, 0xb5, 0x90, 0x4b,
-		0x2c, 0x2d, 0x5a, 0x95, 0x5b, 0x59, 0xab, 0x6c, 0xc8, 0xbe, 0x0c, 0x4e,
-		0x48, 0xf0, 0xc7, 0xdf, 0x3e, 0xaf, 0x34, 0x76, 0x9e, 0x13, 0xdd, 0x8f,
-		0xf7, 0x6f, 0xdf, 0x64, 0x2c, 0x99, 0xe4, 0x8e, 0x7e, 0x92, 0x8c, 0xf6,
-		0x18, 0x1e, 0x87, 0x83, 0x93, 0x71, 0x51, 0x2b, 0x91, 0xed, 0xae, 0x9f,
-		0x14, 0xdc, 0x72, 0x08, 0x71, 0xc2, 0xc6, 0x5a, 0x98, 0xa6, 0x56, 0x46,
-		0x90, 0xb4, 0x4b, 0x6c, 0x1e, 0xd7, 0x98, 0x58, 0x0e, 0xd6, 0x03, 0xf9,
-		0xae, 0x1d, 0x8e, 0xb2, 0xb7, 0x14, 0x88, 0xae, 0xcb, 0xb2, 0x5e, 0xdf,
-		0x98, 0x1b, 0x51, 0x0a, 0x2b, 0x4c, 0xa4, 0xdb, 0x5d, 0x0d, 0xa4, 0xff,
-		0x14, 0xda, 0x40, 0xd9, 0x48, 0x13, 0x3e, 0xc3, 0xe6, 0xab, 0xba, 0x6a,
-		0x64, 0x29, 0xde, 0xcb, 0x4a, 0x44, 0x82, 0xde, 0x12, 0x3c, 0x7e, 0x72,
-		0x32, 0x99, 0xb0, 0x37, 0x62, 0xcd, 0xbc, 0x5b, 0x98, 0x34, 0x4c, 0x0b,
-		0x5e, 0x30, 0xa9, 0x46, 0x6c, 0xde, 0x5a, 0x66, 0x57, 0x02, 0xbf, 0x83,
-		0x61, 0xd8, 0xe4, 0xd6, 0xf2, 0x7c, 0x25, 0x0a, 0x66, 0x6b, 0xda, 0xf3,
-		0xfc, 0x16, 0xb2, 0x34, 0xe3, 0xaa, 0x60, 0xeb, 0x5a, 0x59, 0x36, 0x17,
-		0xac, 0x6d, 0x70, 0x16, 0xa8, 0x8a, 0x8d, 0xe2, 0x95, 0xcc, 0x79, 0x59,
-		0x6e, 0xc6, 0xec, 0xbe, 0x66, 0x85, 0x68, 0x84, 0x2a, 0xa4, 0x5a, 0x32,
-		0x68, 0x4c, 0xb2, 0xbd, 0x80, 0xbc, 0xd5, 0x5a, 0x80, 0xd3, 0x58, 0x70,
-		0xb1, 0x8c, 0xc3, 0xfd, 0x0f, 0x62, 0x22, 0x95, 0xff, 0x31, 0x64, 0x6b,
-		0xc1, 0x94, 0xf0, 0x87, 0xe6, 0x9b, 0xbc, 0x14, 0x60, 0xe5, 0x16, 0xac,
-		0x72, 0xc1, 0x32, 0x58, 0xe9, 0xcf, 0x9f, 0xcd, 0x66, 0xac, 0x55, 0x85,
-		0x58, 0x48, 0x25, 0x0a, 0x1f, 0x3a, 0x92, 0xad, 0x6a, 0xcb, 0x74, 0xab,
-		0x14, 0x0e, 0x1d, 0xe1, 0xa3, 0x6e, 0xb0, 0xfe, 0xc8, 0x44, 0x69, 0x84,
-		0x27, 0x21, 0xfe, 0x7a, 0xb9, 0x2c, 0x45, 0x36, 0xbc, 0x3c, 0xf2, 0xfd,
-		0x18, 0x7c, 0xf4, 0xc1, 0x59, 0xc4, 0xa2, 0xb7, 0x55, 0x5b, 0xcd, 0x85,
-		0xc6, 0xd6, 0xf3, 0x2c, 0xfd, 0x66, 0xa9, 0xce, 0x1f, 0xfc, 0x7a, 0x3a,
-		0x1c, 0x5b, 0xf1, 0xd9, 0x66, 0xdb, 0xb8, 0x90, 0x90, 0x47, 0xfc, 0x03,
-		0x39, 0x31, 0xaf, 0x98, 0x15, 0x55, 0x53, 0x42, 0x5a, 0xa6, 0xeb, 0xf5,
-		0x88, 0x15, 0xc6, 0x38, 0x6d, 0xf1, 0x31, 0x86, 0xee, 0x45, 0x96, 0x8e,
-		0x91, 0x0b, 0xd5, 0xaf, 0xf0, 0x9c, 0x88, 0xf2, 0x40, 0x33, 0x7e, 0x83,
-		0x6f, 0x92, 0xb6, 0x4f, 0x47, 0x7a, 0x81, 0x6e, 0x65, 0xab, 0x32, 0x4b,
-		0xa6, 0x7c, 0x3e, 0xd7, 0x2c, 0x2f, 0xb9, 0x31, 0xb3, 0x94, 0xfc, 0xc2,
-		0x97, 0x75, 0x8a, 0x00, 0xd9, 0x52, 0xcc, 0xd2, 0xe4, 0x8c, 0xe4, 0xbc,
-		0x42, 0x80, 0xad, 0x70, 0x6c, 0x67, 0x49, 0x7a, 0x75, 0x64, 0x71, 0x3a,
-		0x21, 0x29, 0x57, 0xc9, 0xb1, 0xd3, 0xea, 0x86, 0x4c, 0x30, 0x7d, 0xc5,
-		0xde, 0x71, 0xcd, 0x2b, 0xe3, 0x88, 0x85, 0x6d, 0xb5, 0x62, 0xe0, 0xd9,
-		0x35, 0x18, 0x31, 0x37, 0xff, 0x92, 0x76, 0x95, 0x19, 0xab, 0x47, 0xcc,
-		0xb4, 0x8b, 0x85, 0xfc, 0xec, 0x6d, 0xf6, 0x0c, 0x58, 0x1e, 0xe3, 0x0c,
-		0xf1, 0xf9, 0xed, 0x22, 0xf3, 0xbb, 0x23, 0xb7, 0x56, 0x0a, 0xb5, 0xb4,
-		0x2b, 0x76, 0x1e, 0x58, 0xc2, 0xf7, 0x90, 0x3d, 0x43, 0xa8, 0xcf, 0x5f,
-		0xb8, 0x33, 0xe0, 0xe9, 0x3b, 0x51, 0xd5, 0x0f, 0xe2, 0xb5, 0xe2, 0xf3,
-		0x52, 0x14, 0x7f, 0x7f, 0xff, 0xf3, 0x4f, 0x07, 0xcd, 0x80, 0xf2, 0xe4,
-		0xd9, 0x41, 0x59, 0xf9, 0x1c, 0x09, 0x2a, 0xa4, 0xac, 0x90, 0xc6, 0x49,
-		0x60, 0xe9, 0xa0, 0x9f, 0x20, 0x71, 0x9f, 0x56, 0xc3, 0x81, 0xb1, 0x5e,
-		0x6f, 0xab, 0x9d, 0xbe, 0x53, 0x18, 0x0a, 0x19, 0x05, 0x94, 0xdc, 0x38,
-		0x62, 0x6b, 0x59, 0xd8, 0x15, 0x3e, 0x85, 0x96, 0x0f, 0x9c, 0x12, 0x79,
-		0xc4, 0x44, 0x81, 0x48, 0x48, 0xf5, 0xc9, 0x1d, 0x8d, 0xac, 0xba, 0x5d,
-		0xf4, 0xb6, 0xa9, 0xbe, 0x52, 0xab, 0x5b, 0x91, 0x82, 0x50, 0xe5, 0xbc,
-		0x31, 0x2d, 0x65, 0x89, 0xab, 0x93, 0x9b, 0x7b, 0x88, 0x83, 0x2b, 0x78,
-		0x9f, 0x3e, 0x9e, 0xec, 0x44, 0x49, 0xc5, 0x96, 0x41, 0xaf, 0x31, 0xc9,
-		0x55, 0x68, 0x65, 0x24, 0x71, 0x09, 0x4a, 0x45, 0x5a, 0xa0, 0x88, 0x2b,
-		0x49, 0x6b, 0x0b, 0x92, 0x46, 0x21, 0x21, 0x9e, 0x74, 0x7c, 0x9a, 0xd7,
-		0xad, 0xb2, 0x38, 0x13, 0x5f, 0x4e, 0xd2, 0x7a, 0x25, 0xf3, 0x15, 0xcb,
-		0x39, 0x1c, 0xb0, 0x16, 0x69, 0x59, 0x32, 0xe7, 0x00, 0x69, 0xa9, 0x66,
-		0xb9, 0xda, 0xac, 0xf9, 0xc6, 0x8c, 0xbd, 0x4f, 0x7b, 0xba, 0x1c, 0xa9,
-		0x3e, 0xa2, 0xe8, 0x22, 0x1f, 0x9d, 0x93, 0x8c, 0xdd, 0x71, 0xc9, 0x30,
-		0x54, 0x68, 0x5f, 0x04, 0x23, 0xe3, 0xf7, 0xca, 0x73, 0x67, 0x7f, 0xc1,
-		0xb1, 0xee, 0x8a, 0xb2, 0x4f, 0x73, 0x8c, 0x84, 0xca, 0x96, 0xce, 0xcf,
-		0x5c, 0x10, 0xd8, 0x94, 0x7d, 0x77, 0x31, 0x64, 0x7f, 0xfc, 0xc1, 0xc2,
-		0xf7, 0xae, 0xb6, 0x50, 0x85, 0x85, 0x75, 0xf6, 0x97, 0x8b, 0x0b, 0x36,
-		0x39, 0x65, 0xa6, 0x46, 0xc7, 0xc4, 0x3e, 0x6f, 0x4b, 0xcb, 0x4e, 0x27,
-		0xec, 0xd1, 0x8b, 0x8b, 0xf1, 0x3b, 0x30, 0x97, 0x6d, 0x77, 0xbc, 0x0a,
-		0x91, 0xe3, 0x59, 0x2f, 0xe4, 0x68, 0xad, 0x60, 0xa1, 0x89, 0x94, 0xf8,
-		0x5f, 0x93, 0x84, 0x45, 0x3b, 0x7a, 0x7b, 0x58, 0x84, 0xf6, 0xb6, 0x6a,
-		0xdc, 0xc7, 0x65, 0xfc, 0x49, 0xff, 0x9e, 0xb1, 0xdd, 0x09, 0xb2, 0xbb,
-		0x97, 0x40, 0xde, 0x59, 0x14, 0x84, 0xcf, 0xef, 0x9d, 0x51, 0x33, 0x2c,
-		0xfe, 0xcc, 0xed, 0x6a, 0xbc, 0x28, 0xeb, 0x5a, 0x7b, 0x07, 0x0c, 0xf7,
-		0x18, 0xbf, 0xb5, 0x5c, 0x63, 0x46, 0xce, 0x72, 0xea, 0xb8, 0xf7, 0x76,
-		0x83, 0xee, 0x97, 0x90, 0xfe, 0xbd, 0x08, 0x93, 0xfe, 0x3b, 0x3c, 0x8d,
-		0xd0, 0xf7, 0x02, 0x63, 0xa3, 0xc8, 0x48, 0xe1, 0x1d, 0x79, 0xc2, 0x20,
-		0x7b, 0x45, 0x88, 0xf9, 0xf0, 0x6b, 0x82, 0x86, 0x07, 0xfc, 0xc9, 0x28,
-		0x35, 0x32, 0x1d, 0x26, 0xfb, 0x5a, 0xc2, 0x8f, 0xe2, 0xe7, 0xba, 0x10,
-		0x33, 0x1c, 0xab, 0x44, 0x8e, 0x09, 0xf3, 0x2d, 0x47, 0xe3, 0xba, 0x2e,
-		0x9b, 0x15, 0x9f, 0x5d, 0x8c, 0x5f, 0x7c, 0xe7, 0x3e, 0x1d, 0x05, 0x46,
-		0x4e, 0x32, 0xe8, 0x1a, 0x0c, 0x04, 0x50, 0xed, 0x6e, 0xfb, 0x38, 0xd5,
-		0x94, 0x11, 0x48, 0xe9, 0x85, 0x9b, 0x8a, 0x88, 0x77, 0xab, 0x73, 0xe1,
-		0x2a, 0x82, 0xb6, 0x2c, 0x75, 0x02, 0x20, 0x04, 0x59, 0xa2, 0x6a, 0x58,
-		0xdd, 0x5a, 0x62, 0xa5, 0x0d, 0x14, 0x95, 0x71, 0xd3, 0x87, 0x66, 0x12,
-		0x2f, 0x69, 0x56, 0x6e, 0xd8, 0x8a, 0x23, 0xf5, 0xe6, 0xa8, 0x97, 0x82,
-		0x4a, 0x04, 0xc5, 0x45, 0x66, 0x8f, 0xa9, 0x53, 0xf8, 0x39, 0x78, 0x63,
-		0xfe, 0x3c, 0x3c, 0xf9, 0x08, 0xd4, 0x70, 0x08, 0x4e, 0x7c, 0x81, 0x4b,
-		0x2b, 0x31, 0x85, 0x4d, 0x9b, 0x43, 0x53, 0x43, 0x47, 0xd1, 0x60, 0xa5,
-		0x61, 0x1a, 0xf8, 0x9f, 0x00, 0x31, 0xd0, 0xc5, 0x60, 0x88, 0x0b, 0x7d,
-		0xed, 0x46, 0xaa, 0x9f, 0x6d, 0x4f, 0x22, 0x9b, 0xe7, 0x59, 0xf2, 0x4d,
-		0x61, 0x42, 0xb1, 0x86, 0x66, 0x4f, 0xf8, 0x21, 0xf4, 0x60, 0x47, 0x32,
-		0xae, 0x78, 0xe3, 0x56, 0x7b, 0xfa, 0x8a, 0x72, 0x3b, 0x7b, 0xb1, 0x0d,
-		0x27, 0x95, 0xce, 0x73, 0xee, 0x30, 0xc0, 0x03, 0x87, 0x1b, 0x98, 0x6b,
-		0xd3, 0xce, 0xcb, 0x81, 0x14, 0xb5, 0x22, 0x4a, 0x37, 0xe3, 0x3a, 0xb7,
-		0x8a, 0xcf, 0xd2, 0x58, 0x33, 0x62, 0x1f, 0x5b, 0x03, 0x68, 0xf0, 0x49,
-		0x36, 0xe8, 0x41, 0x44, 0x4d, 0x65, 0x85, 0xb1, 0x6b, 0x1a, 0x4e, 0xf3,
-		0xd6, 0xc7, 0x28, 0xa3, 0x8d, 0x93, 0x6e, 0xe0, 0x64, 0x6e, 0x9a, 0xf8,
-		0x92, 0x73, 0x09, 0xf0, 0x3c, 0xb3, 0x2b, 0x69, 0x82, 0x1d, 0x43, 0x94,
-		0x6f, 0x3c, 0xed, 0x92, 0x72, 0xef, 0xe4, 0x64, 0x18, 0x67, 0x0d, 0x76,
-		0x2e, 0xe0, 0xc4, 0x13, 0x67, 0x02, 0x45, 0x49, 0x89, 0xf5, 0x5d, 0xbd,
-		0xa6, 0x30, 0x61, 0xd2, 0xe7, 0x5c, 0x5b, 0x36, 0x8e, 0xf3, 0x1b, 0xa7,
-		0xe7, 0x25, 0x39, 0x7b, 0x08, 0xef, 0xd1, 0x0c, 0x7a, 0x45, 0x73, 0x37,
-		0x4b, 0xb7, 0xfb, 0x0e, 0x51, 0x74, 0xe3, 0xde, 0x8b, 0x42, 0x73, 0x2f,
-		0xe9, 0x84, 0x93, 0x71, 0xa3, 0x1d, 0x1c, 0x7a, 0x5f, 0x07, 0xc9, 0xa9,
-		0x5f, 0x5e, 0xf0, 0x42, 0xdc, 0x22, 0x70, 0x83, 0xe0, 0x9a, 0xeb, 0xa2,
-		0x60, 0x2b, 0x48, 0xd7, 0x93, 0xbc, 0x94, 0xf9, 0x27, 0x26, 0x1e, 0x08,
-		0x2b, 0x95, 0x70, 0x8e, 0x02, 0xc6, 0x08, 0x48, 0x2c, 0xa6, 0xec, 0x5c,
-		0xe4, 0xbc, 0x35, 0xd1, 0xab, 0xb7, 0x0c, 0xc9, 0x90, 0x5a, 0xf6, 0x49,
-		0xc1, 0x84, 0x8f, 0xff, 0x68, 0x85, 0xde, 0x20, 0x71, 0x11, 0x11, 0xa1,
-		0xea, 0x76, 0xb9, 0x22, 0x5e, 0x53, 0x97, 0x0f, 0x54, 0x0f, 0xd2, 0x25,
-		0x3f, 0xe6, 0x8b, 0x5c, 0x2c, 0x04, 0xa1, 0xb1, 0x20, 0x02, 0xed, 0x9e,
-		0x65, 0x9d, 0xa6, 0x2e, 0xe5, 0x18, 0x1a, 0x89, 0x71, 0x75, 0x80, 0xff,
-		0x75, 0x8a, 0x78, 0xe5, 0x9d, 0xa2, 0xfb, 0xd9, 0x47, 0xd9, 0xe4, 0xfd,
-		0xcf, 0x8b, 0x22, 0x38, 0x69, 0xa9, 0x1c, 0x69, 0xf0, 0xd1, 0xe3, 0x41,
-		0xc6, 0x6f, 0x79, 0x76, 0x7c, 0xbb, 0xc7, 0x36, 0x0c, 0x5a, 0x52, 0x31,
-		0x90, 0x73, 0x46, 0x0c, 0x27, 0xc0, 0x0a, 0x40, 0x10, 0xd7, 0x9f, 0xb5,
-		0x02, 0xae, 0x59, 0xf9, 0xd9, 0x49, 0x5e, 0x72, 0x23, 0x32, 0x0b, 0xba,
-		0x3a, 0x8e, 0x43, 0x5d, 0xfd, 0x5c, 0x46, 0x7d, 0xbb, 0xba, 0xe6, 0xcc,
-		0xe1, 0x4b, 0x54, 0x8f, 0x84, 0xc9, 0x7b, 0x72, 0x5b, 0xa4, 0x27, 0x39,
-		0x24, 0x00, 0xd4, 0xc8, 0x0e, 0xb7, 0x16, 0xb5, 0xc7, 0xd2, 0xa4, 0x3a,
-		0x9d, 0x5c, 0x79, 0x7c, 0x2c, 0x52, 0x2d, 0x28, 0x26, 0x84, 0x72, 0x09,
-		0x8c, 0x18, 0xb6, 0xa8, 0x75, 0x54, 0xdd, 0x87, 0xb7, 0x56, 0xb9, 0x60,
-		0x55, 0xad, 0x45, 0x94, 0x36, 0x17, 0x50, 0x44, 0xa2, 0x41, 0x91, 0x95,
-		0xaa, 0xd6, 0x15, 0x9a, 0x00, 0x86, 0xb7, 0x2d, 0x52, 0x6f, 0x07, 0xd1,
-		0xbc, 0x7f, 0x7b, 0xf3, 0x76, 0xd4, 0x0f, 0xf6, 0x0a, 0xff, 0x79, 0x35,
-		0x36, 0xc2, 0x3e, 0x1b, 0x44, 0x3a, 0x82, 0x72, 0x34, 0xc4, 0x9d, 0x36,
-		0xae, 0x34, 0x75, 0x6a, 0xbc, 0x7b, 0x1c, 0x68, 0x64, 0x3d, 0x5b, 0x89,
-		0xa4, 0x6f, 0x40, 0xb6, 0xe6, 0xc8, 0x3b, 0x08, 0xfd, 0x24, 0x44, 0xc3,
-		0x3e, 0xdc, 0x46, 0x99, 0x46, 0x22, 0xbf, 0x85, 0x33, 0x84, 0x4e, 0x26,
-		0xb6, 0x5a, 0x95, 0xa1, 0x2d, 0x92, 0xa9, 0x35, 0x72, 0x07, 0x37, 0x07,
-		0xb8, 0x92, 0xa4, 0x87, 0xd8, 0x76, 0xc5, 0xd4, 0x1d, 0x98, 0xc6, 0x50,
-		0x53, 0x77, 0x8a, 0xc2, 0x49, 0x62, 0x5e, 0x37, 0x1b, 0x62, 0x0e, 0x75,
-		0xe4, 0x75, 0x47, 0x98, 0x5d, 0x1f, 0x86, 0x77, 0xc8, 0x26, 0x0a, 0x2e,
-		0x05, 0xc7, 0xf7, 0x66, 0x6e, 0x7c, 0xd7, 0x70, 0x1e, 0x0d, 0x71, 0xa1,
-		0x62, 0xf6, 0xe3, 0xe4, 0xb0, 0x92, 0x0b, 0xb3, 0xad, 0x65, 0x47, 0x7c,
-		0xbc, 0xa0, 0x89, 0xcc, 0x6f, 0x6f, 0xf3, 0xb8, 0xaf, 0xbf, 0xcb, 0x49,
-		0x9a, 0x59, 0x01, 0x32, 0xdb, 0xe2, 0xe5, 0x42, 0x6a, 0x63, 0x23, 0x97,
-		0xc3, 0xe8, 0xee, 0xe7, 0x49, 0x32, 0x2d, 0xe4, 0x43, 0x1f, 0xa8, 0x7b,
-		0x19, 0x18, 0x6c, 0x96, 0x63, 0xca, 0x69, 0x00, 0x73, 0x4f, 0x78, 0x96,
-		0x30, 0x36, 0x95, 0x40, 0x9a, 0x81, 0x16, 0x3f, 0xcf, 0x75, 0x4d, 0x39,
-		0x58, 0xa4, 0xcc, 0xe8, 0x7c, 0xf6, 0x4b, 0x92, 0x9c, 0x79, 0xd2, 0x93,
-		0x5d, 0x6c, 0x1a, 0x4e, 0xda, 0x16, 0x92, 0x53, 0x2a, 0x89, 0x4a, 0xc5,
-		0xae, 0x3e, 0x1c, 0x05, 0x8d, 0x08, 0x31, 0x26, 0xe1, 0xa3, 0x07, 0x18,
-		0x22, 0xb7, 0x03, 0x0e, 0x28, 0x92, 0x53, 0x76, 0x31, 0xfe, 0xeb, 0x70,
-		0x78, 0x96, 0xfc, 0x92, 0x74, 0x3a, 0xb2, 0x74, 0x4a, 0xed, 0x38, 0xe8,
-		0x98, 0x58, 0x33, 0xb7, 0xe8, 0xf4, 0x75, 0x39, 0xe7, 0x3a, 0xb9, 0x4a,
-		0x3b, 0x22, 0x58, 0xb2, 0xb5, 0x3a, 0x01, 0xcd, 0xf9, 0x12, 0xa6, 0x34,
-		0xac, 0xfb, 0x75, 0x6e, 0xaa, 0x5d, 0x7a, 0x70, 0x70, 0xb6, 0xd2, 0x62,
-		0x31, 0x4b, 0xd2, 0xb3, 0x3d, 0xf3, 0xbe, 0x66, 0x56, 0x30, 0xa8, 0x83,
-		0x69, 0xbd, 0x5f, 0x04, 0x34, 0x87, 0x67, 0x69, 0xc2, 0xec, 0xa6, 0x11,
-		0xd0, 0xa4, 0xb5, 0xb6, 0x56, 0x49, 0x4f, 0x31, 0xa7, 0x52, 0xc0, 0x7f,
-		0xc9, 0xd5, 0x6b, 0x60, 0xb8, 0xe9, 0xc4, 0x53, 0x1d, 0xaa, 0x27, 0x0b,
-		0xc7, 0xe2, 0xaf, 0x21, 0x49, 0xd0, 0xf6, 0xcf, 0x89, 0x4e, 0x1d, 0xa0,
-		0x3b, 0xb8, 0xc0, 0xc0, 0xcb, 0x50, 0xee, 0xca, 0xaf, 0x1f, 0x3d, 0x98,
-		0xfc, 0xd8, 0x5b, 0x98, 0x4e, 0xc8, 0xfd, 0x3b, 0x0b, 0x5b, 0x82, 0x90,
-		0x7c, 0xe8, 0xdf, 0x31, 0xb9, 0x7d, 0xe3, 0xeb, 0x75, 0xdc, 0x2f, 0x6c,
-		0xb7, 0xd9, 0x42, 0x81, 0xc7, 0x40, 0x2b, 0x81, 0x09, 0xb4, 0xbd, 0x5e,
-		0xd0, 0x90, 0x8d, 0x65, 0xd3, 0x35, 0xe1, 0x6e, 0x56, 0x6d, 0x0b, 0x56,
-		0x2e, 0x3c, 0x34, 0x42, 0x73, 0x03, 0x80, 0x76, 0x17, 0xad, 0x51, 0x78,
-		0x36, 0x40, 0x0d, 0xaf, 0x50, 0xbb, 0xa5, 0xbb, 0x38, 0x77, 0xb7, 0xf7,
-		0x63, 0x97, 0x32, 0x02, 0xfe, 0xc6, 0x81, 0x81, 0x19, 0xfb, 0x4a, 0x98,
-		0x7d, 0xcd, 0x39, 0xb8, 0xb2, 0x0d, 0xc2, 0xf0, 0x58, 0x6f, 0x0f, 0xe5,
-		0xf0, 0x7c, 0xdc, 0xd4, 0xc6, 0x66, 0xc9, 0xc4, 0x6b, 0x47, 0x60, 0xeb,
-		0x8b, 0x43, 0x27, 0x1e, 0x03, 0x12, 0x76, 0x7b, 0xc9, 0xc2, 0xe9, 0x8f,
-		0x6c, 0x18, 0xb8, 0xf6, 0x50, 0x15, 0xd1, 0x77, 0xaa, 0x92, 0x4c, 0xa4,
-		0xef, 0xba, 0xcc, 0xba, 0x85, 0x13, 0x77, 0xe5, 0x7e, 0x49, 0x75, 0x61,
-		0x75, 0xad, 0x96, 0x57, 0x37, 0xaf, 0x7f, 0x7a, 0xfd, 0xfe, 0xf6, 0xcd,
-		0x0f, 0xec, 0xe6, 0xfa, 0xfd, 0x35, 0xbb, 0x7f, 0xfb, 0xe1, 0xee, 0xd5,
-		0x6b, 0x44, 0xcd, 0x6f, 0x4e, 0xe7, 0x7a, 0x72, 0x35, 0x95, 0x57, 0xe9,
-		0x99, 0x3f, 0xf7, 0x0c, 0xf1, 0x93, 0x7e, 0x35, 0x1d, 0x75, 0x22, 0xd1,
-		0x23, 0x0c, 0x5f, 0x92, 0x50, 0xe7, 0x2b, 0x02, 0x7e, 0x1d, 0xb0, 0xf2,
-		0xba, 0xb3, 0x75, 0xad, 0xd1, 0xee, 0xdc, 0xfd, 0x0f, 0xf7, 0x35, 0x2d,
-		0xce, 0x79, 0xd3, 0x08, 0x0e, 0x90, 0x80, 0xfc, 0x25, 0x69, 0x18, 0x49,
-		0x01, 0x20, 0x1c, 0xb0, 0x36, 0xba, 0x7e, 0x90, 0x74, 0x71, 0xf8, 0x24,
-		0x1a, 0x77, 0xd5, 0x33, 0xe1, 0xa5, 0xa6, 0x12, 0x56, 0xcb, 0xdc, 0x8c,
-		0xd3, 0xa8, 0xc8, 0xe3, 0xa8, 0x67, 0x26, 0x92, 0x1c, 0x0a, 0x05, 0x4c,
-		0x9a, 0xd2, 0x3d, 0xb3, 0xe4, 0x9b, 0x97, 0xb8, 0x6e, 0x5d, 0x5c, 0x8c,
-		0x30, 0xc5, 0x70, 0xd2, 0xaf, 0x94, 0x2a, 0xa0, 0x69, 0x78, 0xfb, 0x3b,
-		0x5d, 0x6d, 0xeb, 0xc5, 0x02, 0xc8, 0xfb, 0x25, 0xfb, 0xff, 0xef, 0x3a,
-		0x81, 0x31, 0x88, 0x2e, 0x81, 0xb6, 0x23, 0xc7, 0x35, 0xf4, 0x85, 0xae,
-		0xab, 0x2d, 0xc0, 0xd9, 0xb6, 0x34, 0xdc, 0x94, 0x5f, 0xae, 0xb8, 0xc9,
-		0x90, 0x10, 0xa1, 0x69, 0x9a, 0x2c, 0xd9, 0x3a, 0x10, 0x17, 0xcc, 0xde,
-		0x3c, 0x19, 0xc4, 0x83, 0x62, 0x34, 0x17, 0x5c, 0x96, 0xff, 0xf3, 0xd1,
-		0x2c, 0x64, 0xe1, 0x9e, 0xaf, 0x28, 0xaa, 0x8e, 0xfd, 0x5d, 0x6d, 0x8c,
-		0x84, 0x17, 0xe8, 0x8d, 0x0e, 0xf7, 0x04, 0x83, 0xd6, 0xa0, 0xaf, 0xa6,
-		0x6d, 0x79, 0x35, 0x2d, 0xe5, 0xd5, 0x3b, 0xa1, 0x57, 0xbc, 0x31, 0xfe,
-		0xc9, 0x0e, 0x13, 0x0b, 0xb3, 0x1f, 0x43, 0x9c, 0xf8, 0x1d, 0x90, 0x9e,
-		0x4e, 0x40, 0x13, 0xe8, 0x2a, 0x69, 0xe8, 0x6d, 0xca, 0x50, 0xd0, 0x21,
-		0xae, 0x62, 0xfe, 0x6e, 0x70, 0x6e, 0x90, 0x00, 0x9e, 0x6e, 0x02, 0xa1,
-		0x4f, 0x05, 0xbc, 0xe0, 0x6a, 0x89, 0x06, 0xf0, 0xdf, 0xc6, 0xbb, 0x0b,
-		0xc8, 0x65, 0xac, 0xce, 0x6d, 0x02, 0x10, 0x3a, 0x21, 0xe4, 0x40, 0xef,
-		0x7e, 0xdd, 0x7c, 0x1c, 0x74, 0xe1, 0x7f, 0x0a, 0x3e, 0x04, 0xaa, 0x80,
-		0xfd, 0xe3, 0xab, 0x41, 0xef, 0xe4, 0xc7, 0xc1, 0xf6, 0x03, 0x27, 0xfa,
-		0x6b, 0x1a, 0x4b, 0x6e, 0xf0, 0x2f, 0x5a, 0x75, 0x5d, 0xb6, 0x95, 0xf2,
-		0xa0, 0x68, 0xd5, 0x56, 0x5c, 0xc9, 0xdf, 0xa3, 0x0e, 0x96, 0x57, 0x8d,
-		0xd3, 0xc1, 0x63, 0xe9, 0x2c, 0xa1, 0x87, 0xb2, 0x71, 0x78, 0x67, 0xa3,
-		0x96, 0xe4, 0x7f, 0xc5, 0xa7, 0xc3, 0x70, 0x86, 0xbb, 0x95, 0xed, 0x66,
-		0xdc, 0xde, 0xad, 0xec, 0x36, 0x3c, 0x75, 0x7a, 0x46, 0xe4, 0x33, 0x50,
-		0xb9, 0x18, 0x97, 0xf5, 0x12, 0x57, 0xc2, 0xd7, 0x77, 0x77, 0x6f, 0xef,
-		0x5e, 0xb2, 0x57, 0x75, 0x5b, 0xfa, 0x74, 0xc0, 0xc5, 0x1b, 0x58, 0xab,
-		0x8a, 0xb7, 0x3e, 0xf8, 0xeb, 0xb7, 0x16, 0xaa, 0x8d, 0xd9, 0xbd, 0x93,
-		0x85, 0xb8, 0xaf, 0xd5, 0xf7, 0x09, 0x0b, 0x27, 0xfb, 0x6b, 0x6f, 0x3c,
-		0xd8, 0x5d, 0x17, 0x8c, 0x5c, 0x2a, 0xee, 0x6f, 0x65, 0xf4, 0xe2, 0xda,
-		0x1a, 0xba, 0x06, 0x87, 0x2b, 0xb5, 0x7c, 0x90, 0x76, 0x43, 0x44, 0x73,
-		0x0e, 0xe4, 0x04, 0x60, 0xe5, 0x15, 0x1c, 0xec, 0x5d, 0x20, 0x0f, 0x2e,
-		0xb4, 0xd4, 0x7f, 0x3d, 0x01, 0x04, 0x25, 0x7d, 0xa0, 0x5f, 0xf2, 0xb9,
-		0x28, 0xcf, 0x63, 0x87, 0x20, 0xad, 0xf6, 0x69, 0x77, 0xb0, 0x96, 0x27,
-		0x0f, 0xf9, 0x15, 0xa9, 0x29, 0xd6, 0xfb, 0x84, 0x56, 0x73, 0x85, 0xa9,
-		0x47, 0x57, 0x95, 0xd4, 0x3d, 0xb0, 0x1e, 0x78, 0xf3, 0xab, 0x4a, 0x1e,
-		0x39, 0xf8, 0x29, 0x3d, 0xf7, 0x6d, 0x3a, 0xae, 0x64, 0x0f, 0x1a, 0xee,
-		0x6b, 0x48, 0x91, 0xb8, 0xb7, 0x20, 0x73, 0x8f, 0xd1, 0xf7, 0xb6, 0x6e,
-		0x1a, 0xaa, 0x7b, 0xc2, 0xb3, 0xee, 0xcd, 0xd9, 0x3d, 0x8c, 0x03, 0xe7,
-		0x87, 0x88, 0xc6, 0xbb, 0x1d, 0xb1, 0x79, 0x3d, 0xdc, 0xa3, 0x82, 0x21,
-		0x09, 0x47, 0xde, 0x38, 0xdd, 0x13, 0xa7, 0x7b, 0x0b, 0xef, 0xb2, 0x2b,
-		0xbc, 0x8c, 0xd3, 0x53, 0x47, 0xfc, 0x33, 0xc4, 0x4e, 0x0e, 0xf6, 0x9e,
-		0x28, 0x90, 0x7a, 0xb8, 0x84, 0x1d, 0xf9, 0x7b, 0xc6, 0x30, 0xbe, 0x06,
-		0xac, 0xd0, 0x1d, 0xfe, 0xe6, 0xe1, 0x4c, 0x18, 0xbe, 0xe9, 0x3b, 0x1a,
-		0x25, 0x0e, 0xdd, 0x1e, 0x12, 0xb8, 0x47, 0xf4, 0xe0, 0x09, 0x07, 0x79,
-		0xb6, 0xde, 0xfa, 0x13, 0xd4, 0xfd, 0x38, 0x3c, 0x86, 0xe0, 0xc2, 0x5f,
-		0x07, 0x66, 0xe7, 0x25, 0xe6, 0x5b, 0x67, 0x5b, 0xe7, 0x00, 0x70, 0xf5,
-		0xcc, 0xef, 0x80, 0x5f, 0x88, 0xd4, 0x11, 0x4b, 0x5c, 0x5a, 0xbb, 0x3b,
-		0xfb, 0x31, 0x92, 0x27, 0x6c, 0xf9, 0x8f, 0x4c, 0x71, 0x76, 0x78, 0x82,
-		0xa3, 0x8f, 0xd4, 0x4f, 0xfd, 0x31, 0x23, 0x46, 0xde, 0xf5, 0x88, 0xde,
-		0xf3, 0x67, 0xf0, 0x4c, 0x16, 0x3d, 0x35, 0xa0, 0x8b, 0x0e, 0x3d, 0x16,
-		0xc5, 0xa7, 0xc8, 0xcb, 0xc1, 0xf3, 0xac, 0xa8, 0xf3, 0xb6, 0x42, 0x1e,
-		0x52, 0xce, 0xf3, 0x62, 0x93, 0x1d, 0x79, 0x1f, 0x27, 0x96, 0xa1, 0x7b,
-		0xb7, 0xf5, 0xbc, 0x84, 0x91, 0x5d, 0x5b, 0xee, 0xff, 0x9d, 0xcd, 0xf5,
-		0xd6, 0xad, 0x26, 0x83, 0x63, 0xb1, 0x3c, 0x40, 0x97, 0x47, 0xff, 0xd0,
-		0xe2, 0xbb, 0xef, 0x4e, 0x02, 0xe2, 0xfb, 0x89, 0x44, 0xed, 0xe9, 0x40,
-		0x99, 0x3a, 0x39, 0x65, 0x2f, 0x58, 0x25, 0x55, 0x8b, 0xc6, 0x7d, 0x3a,
-		0x61, 0x2f, 0x4e, 0xff, 0xef, 0xe2, 0xf4, 0x05, 0xa6, 0x50, 0x70, 0x03,
-		0xfe, 0xf7, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x69, 0xbd, 0x75, 0xd1,
-		0x5c, 0x1c, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x00, 0xff, 0xcc, 0x5b,
+		0x5f, 0x93, 0xdb, 0x36, 0x92, 0x7f, 0x96, 0x3e, 0x45, 0x9b, 0xf1, 0x99,
+		0xa4, 0x87, 0x43, 0x69, 0x9c, 0xe4, 0x76, 0x6f, 0x66, 0x34, 0x29, 0xc7,
+		0xe3, 0xda, 0x9d, 0xad, 0x24, 0xf6, 0xd9, 0xce, 0xde, 0x83, 0xd7, 0x95,
+		0x82, 0xc8, 0x96, 0x84, 0x98, 0x04, 0xb8, 0x00, 0x28, 0x8d, 0xd6, 0xd1,
+		0x77, 0xbf, 0x6a, 0xfc, 0x21, 0xa9, 0x3f, 0xf6, 0xf8, 0x6a, 0x5f, 0xee,
+		0x65, 0x2c, 0x02, 0x8d, 0x46, 0xa3, 0xbb, 0xd1, 0xe8, 0xfe, 0x01, 0x5e,
+		0x33, 0x05, 0x4b, 0x01, 0x33, 0xf8, 0xb4, 0xbb, 0x1a, 0x8f, 0x97, 0x22,
+		0xff, 0x9b, 0x96, 0xe2, 0x75, 0x5b, 0x55, 0x77, 0xc2, 0xa0, 0x5a, 0xb3,
+		0x0a, 0x66, 0x30, 0xbd, 0xa2, 0x8e, 0xbf, 0x28, 0xd6, 0xac, 0xb8, 0xc1,
+		0x5f, 0xdf, 0xfc, 0x04, 0x33, 0x88, 0x63, 0xdb, 0xb8, 0x44, 0xf3, 0x42,
+		0x8a, 0x05, 0x5f, 0xc2, 0x0c, 0x16, 0xad, 0x28, 0x0c, 0x97, 0x22, 0x49,
+		0xe1, 0xd3, 0x78, 0x44, 0x8c, 0x7f, 0xff, 0xe7, 0xfd, 0x4a, 0xc1, 0x0c,
+		0x1e, 0xe7, 0x4b, 0x34, 0x7f, 0x7b, 0xfb, 0xea, 0x97, 0x04, 0xa2, 0x49,
+		0x61, 0xe9, 0x27, 0x51, 0x76, 0x30, 0x60, 0x97, 0x8e, 0x47, 0x79, 0x29,
+		0x05, 0x26, 0xfb, 0xed, 0xa3, 0x92, 0x19, 0x06, 0x33, 0xc7, 0x2c, 0x57,
+		0xa8, 0x1b, 0x29, 0x34, 0x12, 0xb7, 0xab, 0xf1, 0x68, 0x74, 0x5a, 0x62,
+		0x1a, 0x72, 0xd4, 0xee, 0xc9, 0xf7, 0xd7, 0x61, 0x29, 0x07, 0x4d, 0x9e,
+		0xe8, 0x79, 0x55, 0xc9, 0xcd, 0xad, 0xbe, 0xc5, 0x0a, 0x0d, 0xea, 0x40,
+		0xb7, 0xdf, 0xea, 0x49, 0x7f, 0x92, 0x05, 0xab, 0xde, 0xa0, 0x28, 0x51,
+		0x05, 0xba, 0x41, 0x93, 0x27, 0x7a, 0xad, 0xe4, 0xfd, 0x76, 0x9f, 0x68,
+		0xd0, 0xe4, 0x89, 0x7e, 0x64, 0xc5, 0x47, 0x14, 0x65, 0x37, 0x5d, 0xf8,
+		0xf6, 0xdd, 0x7f, 0x47, 0xa5, 0xb9, 0x14, 0xa1, 0xd7, 0x7f, 0xfa, 0xce,
+		0x17, 0xb2, 0x6e, 0x78, 0x85, 0xef, 0x78, 0x8d, 0x81, 0x60, 0xd0, 0x74,
+		0x35, 0x1e, 0x8f, 0x46, 0x93, 0x09, 0xfc, 0x82, 0x1b, 0x70, 0xfa, 0x07,
+		0xae, 0x41, 0x21, 0x2b, 0x81, 0x8b, 0x0c, 0xe6, 0xad, 0x01, 0xb3, 0x42,
+		0xe0, 0x41, 0x83, 0x5c, 0x03, 0x33, 0x86, 0x15, 0x2b, 0x2c, 0xc1, 0x48,
+		0xea, 0x73, 0xe3, 0x0d, 0xaf, 0x51, 0x01, 0x13, 0x25, 0x6c, 0xa4, 0x30,
+		0x30, 0x47, 0x68, 0x9b, 0x92, 0x19, 0x2c, 0xa1, 0xdc, 0x0a, 0x56, 0xf3,
+		0x82, 0x55, 0xd5, 0x36, 0x87, 0xb7, 0x12, 0x4a, 0x6c, 0x50, 0x94, 0x5c,
+		0x2c, 0x41, 0x0a, 0x1a, 0x0f, 0x8e, 0x41, 0xd1, 0x2a, 0x85, 0xc2, 0x80,
+		0x36, 0xcc, 0x20, 0x24, 0xac, 0x30, 0x7c, 0x8d, 0x13, 0x2e, 0xdc, 0x8f,
+		0x14, 0x36, 0x08, 0x02, 0xdd, 0xa4, 0xc5, 0xb6, 0xa8, 0x10, 0xcc, 0x8a,
+		0x99, 0xf1, 0x68, 0xc4, 0x17, 0x90, 0x2c, 0x45, 0xee, 0xe6, 0x9f, 0xcd,
+		0x66, 0xd0, 0x8a, 0x12, 0x17, 0x5c, 0x60, 0x69, 0x7d, 0xc7, 0xf2, 0x16,
+		0xd2, 0x80, 0x6a, 0x85, 0xe0, 0x62, 0x99, 0x81, 0x90, 0xb2, 0x19, 0x8f,
+		0x46, 0x3b, 0xc0, 0x4a, 0xa3, 0x23, 0xa1, 0xf1, 0x72, 0xb9, 0xac, 0x30,
+		0x49, 0xaf, 0x4e, 0x7c, 0xef, 0xbc, 0x8e, 0x7e, 0xb5, 0x2b, 0x82, 0xa0,
+		0x6d, 0xd1, 0xd6, 0x73, 0x54, 0xe3, 0xd1, 0xe8, 0x71, 0x12, 0x7f, 0xb3,
+		0x14, 0xe7, 0x6b, 0xd7, 0x1e, 0xa7, 0xb9, 0xc1, 0x7b, 0x93, 0xf4, 0x76,
+		0x21, 0xa6, 0xbb, 0xf4, 0x6a, 0xbc, 0x1b, 0x8f, 0x83, 0x03, 0x83, 0xc1,
+		0xba, 0xa9, 0x98, 0xc1, 0x44, 0xc9, 0x4d, 0x06, 0xa5, 0xd6, 0x56, 0x5a,
+		0x25, 0x37, 0xf9, 0x82, 0x8b, 0x32, 0x89, 0x73, 0x6e, 0xb0, 0xfe, 0x4d,
+		0xb0, 0x1a, 0x03, 0xbf, 0x52, 0xeb, 0xfc, 0x17, 0x56, 0x23, 0x71, 0x23,
+		0x3a, 0x66, 0x8c, 0x4a, 0x62, 0x32, 0xf8, 0xf9, 0xdc, 0xb9, 0x43, 0x6c,
+		0x19, 0x05, 0xe7, 0x20, 0x3a, 0x52, 0x0e, 0x35, 0xfd, 0x45, 0xb1, 0x05,
+		0x13, 0xec, 0xd7, 0x37, 0x3f, 0xa5, 0xf0, 0x09, 0x0e, 0x46, 0x2f, 0x5d,
+		0xa7, 0x1f, 0x3d, 0x20, 0xbd, 0x82, 0xdd, 0x78, 0xf4, 0x38, 0x89, 0xae,
+		0x79, 0xbd, 0x84, 0xa2, 0x62, 0x5a, 0xcf, 0x62, 0xdd, 0x30, 0xf5, 0xb1,
+		0xe2, 0x02, 0x63, 0x60, 0x95, 0x99, 0xc5, 0x31, 0x6c, 0x78, 0x69, 0x56,
+		0xb3, 0xf8, 0xe2, 0xd9, 0x34, 0x86, 0x15, 0xf2, 0xe5, 0xca, 0xcc, 0xe2,
+		0x67, 0xdf, 0xc5, 0x37, 0x51, 0x3a, 0x1e, 0x8d, 0xfc, 0x3c, 0x5a, 0x15,
+		0x71, 0x06, 0xf1, 0xc4, 0x8e, 0x9e, 0xc4, 0x70, 0x06, 0x28, 0x0a, 0x59,
+		0xe2, 0xaf, 0x6f, 0xee, 0xc8, 0x17, 0xa5, 0x40, 0x31, 0x58, 0x20, 0x9c,
+		0x41, 0x9c, 0xeb, 0xf5, 0x32, 0xb6, 0x1c, 0xb8, 0xd0, 0xa8, 0xcc, 0xf3,
+		0x85, 0x41, 0x95, 0x9c, 0xd6, 0x4f, 0x7a, 0x75, 0xac, 0x39, 0xb2, 0x54,
+		0x9c, 0xe6, 0x2b, 0x53, 0x57, 0x49, 0x74, 0xcd, 0xe6, 0x73, 0x15, 0x56,
+		0x40, 0x9e, 0xc2, 0x96, 0x32, 0x06, 0xc3, 0x4d, 0x85, 0xb3, 0x38, 0x3a,
+		0xa3, 0x89, 0x5f, 0x28, 0x64, 0x06, 0xed, 0xb0, 0xb3, 0x28, 0xbe, 0x39,
+		0xd1, 0x78, 0x3d, 0x21, 0x2e, 0x37, 0xd1, 0xa9, 0xd9, 0x64, 0x43, 0x46,
+		0xd5, 0x43, 0x53, 0xbd, 0x66, 0x8a, 0xd5, 0x7a, 0x68, 0x84, 0xbb, 0xf2,
+		0x35, 0x33, 0x06, 0x95, 0xb0, 0xa6, 0xb6, 0x7e, 0xc9, 0x6a, 0x04, 0x8d,
+		0x58, 0x6b, 0xeb, 0xd6, 0x52, 0x18, 0xc6, 0x05, 0xdc, 0xdd, 0xea, 0x0c,
+		0x36, 0x2b, 0x5e, 0xac, 0xa0, 0x46, 0x26, 0x34, 0x30, 0x10, 0xb8, 0x81,
+		0x1a, 0x8d, 0xe2, 0x05, 0x2c, 0xa4, 0x02, 0x5c, 0xa3, 0xda, 0xc2, 0xdd,
+		0xad, 0xf5, 0xbd, 0xe8, 0x5a, 0x37, 0x4c, 0x84, 0xd5, 0x55, 0x6c, 0x8e,
+		0x15, 0xd8, 0xbf, 0xe7, 0x1b, 0xa6, 0xc8, 0xe5, 0xbb, 0x95, 0xfe, 0x95,
+		0x2f, 0x57, 0x50, 0x30, 0x55, 0x72, 0xc1, 0x2a, 0x6e, 0xb6, 0x97, 0x60,
+		0x56, 0x5c, 0x03, 0x39, 0x59, 0x98, 0x5c, 0xbb, 0xd9, 0x35, 0x22, 0x4c,
+		0x06, 0x94, 0x93, 0xf8, 0xe6, 0x9a, 0x8c, 0x27, 0x9c, 0x55, 0x47, 0xfd,
+		0x32, 0xfb, 0x45, 0xd1, 0xc6, 0xf9, 0xa2, 0xb1, 0x3a, 0x25, 0x91, 0x52,
+		0xc8, 0xb3, 0x72, 0x64, 0xc5, 0xca, 0x9a, 0xfd, 0xef, 0x5c, 0x56, 0xcc,
+		0xf6, 0xc2, 0x1f, 0x7f, 0xc0, 0xfb, 0x0f, 0x83, 0x13, 0x80, 0x67, 0xb0,
+		0x1e, 0x2a, 0x8c, 0x02, 0x87, 0x6a, 0x2b, 0xd4, 0x4e, 0x78, 0xf2, 0x60,
+		0xd0, 0xb2, 0x55, 0x05, 0xc2, 0x5c, 0x21, 0xfb, 0xa8, 0x33, 0x58, 0x31,
+		0x55, 0x82, 0x14, 0xa8, 0x81, 0x0b, 0x50, 0x58, 0x7e, 0x4e, 0x4d, 0x87,
+		0x8b, 0x62, 0x65, 0xf9, 0x82, 0xb4, 0x98, 0xac, 0xf3, 0xb7, 0xa4, 0x62,
+		0x6e, 0xb6, 0x30, 0x9b, 0x41, 0x4c, 0xfc, 0x62, 0xf8, 0x01, 0xdc, 0xa8,
+		0xf3, 0x92, 0x89, 0x25, 0xaa, 0x18, 0x2e, 0xbb, 0x06, 0x5c, 0xb0, 0xb6,
+		0x32, 0xb1, 0xe7, 0x62, 0x3d, 0xde, 0xaa, 0x3c, 0xce, 0x60, 0x9d, 0xff,
+		0x8c, 0x5a, 0xb3, 0x25, 0x0e, 0xf4, 0xb6, 0xce, 0xdf, 0xb4, 0x15, 0xfe,
+		0x5f, 0x15, 0x46, 0x7f, 0x14, 0x9a, 0x56, 0x09, 0xda, 0xc0, 0xfb, 0xd1,
+		0x84, 0xce, 0x82, 0xff, 0xe1, 0x66, 0x95, 0x68, 0xa3, 0x32, 0xd0, 0xed,
+		0x62, 0xc1, 0xef, 0xad, 0xd2, 0xfc, 0x00, 0x6d, 0x54, 0xce, 0x45, 0x89,
+		0xf7, 0xaf, 0x16, 0x89, 0xeb, 0xcd, 0x6c, 0x5b, 0x85, 0x62, 0x69, 0x56,
+		0x70, 0xee, 0x87, 0xf8, 0xef, 0x14, 0x1e, 0xcd, 0x66, 0x70, 0x7e, 0x61,
+		0xe7, 0x58, 0x8a, 0xfc, 0x0d, 0xd6, 0x72, 0x8d, 0x2f, 0x05, 0x9b, 0x57,
+		0x58, 0xfe, 0xf5, 0xdd, 0xcf, 0x3f, 0x1d, 0x1d, 0xe9, 0xe4, 0xe2, 0x8f,
+		0x8e, 0x0e, 0x47, 0x2b, 0x41, 0x90, 0x39, 0x86, 0x92, 0x6b, 0xcb, 0x01,
+		0xe2, 0xf1, 0x30, 0xfa, 0x86, 0x7e, 0x6a, 0xf5, 0x13, 0x86, 0x53, 0xf7,
+		0xae, 0xde, 0xcb, 0x1e, 0x4a, 0x4d, 0xae, 0x4a, 0x61, 0x8a, 0xb6, 0x69,
+		0xe6, 0x22, 0x4f, 0x06, 0x25, 0x2a, 0xbe, 0x66, 0x74, 0x4a, 0x64, 0x80,
+		0x25, 0x37, 0x15, 0x17, 0x1f, 0x33, 0xf0, 0x31, 0xd1, 0xca, 0x30, 0x99,
+		0xc0, 0xdd, 0x62, 0x40, 0x47, 0x47, 0x5c, 0x6c, 0x54, 0x8b, 0x71, 0x46,
+		0x81, 0x88, 0x35, 0xba, 0xa5, 0x58, 0x6c, 0x4f, 0xa3, 0xdb, 0xb7, 0xb0,
+		0xe1, 0x66, 0x05, 0x6c, 0x48, 0x1f, 0x44, 0x18, 0xd3, 0xae, 0xe5, 0x02,
+		0x96, 0x5e, 0xc0, 0x9c, 0xf8, 0x0a, 0x29, 0x2c, 0xc7, 0x25, 0x5f, 0xa3,
+		0x20, 0x71, 0x0c, 0xaa, 0x9a, 0x53, 0xdb, 0x02, 0x6e, 0xdf, 0x5a, 0xdb,
+		0x90, 0x23, 0xc6, 0xf9, 0xd3, 0x42, 0xb6, 0xc2, 0xc4, 0x19, 0x7d, 0x59,
+		0x4e, 0x6e, 0x93, 0x17, 0x4c, 0x23, 0x6c, 0x30, 0xae, 0x2a, 0xb0, 0x9a,
+		0xe0, 0x86, 0x4e, 0x46, 0x26, 0xb6, 0x1b, 0xb6, 0xd5, 0xb9, 0x8f, 0x1f,
+		0xbd, 0x2c, 0x27, 0xce, 0x38, 0xa2, 0xe8, 0x5c, 0x20, 0x68, 0x29, 0xca,
+		0xed, 0x74, 0x51, 0xea, 0xcf, 0xc1, 0xc1, 0x72, 0x66, 0x40, 0x8b, 0x3f,
+		0x38, 0x04, 0xf7, 0xfa, 0x17, 0xac, 0xd2, 0x96, 0x60, 0xcf, 0x54, 0xa7,
+		0x48, 0xe8, 0x70, 0xa4, 0xf9, 0x13, 0x6b, 0x0d, 0xb8, 0x86, 0xef, 0xa7,
+		0x29, 0x6d, 0x62, 0xff, 0xbd, 0x2f, 0x2d, 0x9d, 0x3e, 0xbe, 0x1d, 0xfe,
+		0x3c, 0x9d, 0xc2, 0xe4, 0x29, 0x68, 0x59, 0x23, 0xf8, 0x1d, 0x04, 0x4f,
+		0x27, 0x74, 0xe4, 0x10, 0xbb, 0x60, 0xc8, 0xa3, 0xe5, 0x76, 0x26, 0x0e,
+		0x22, 0x84, 0x11, 0x8f, 0x42, 0x07, 0x11, 0xa9, 0x90, 0x50, 0x45, 0xee,
+		0xd7, 0x24, 0x82, 0xb0, 0x8e, 0x41, 0x5f, 0x04, 0x24, 0xbd, 0xa9, 0x1b,
+		0xfb, 0x71, 0x65, 0x6d, 0x62, 0x4d, 0xab, 0xa1, 0x20, 0xb1, 0xcc, 0x4a,
+		0xc9, 0x76, 0xb9, 0xea, 0xcc, 0x7d, 0x2e, 0x70, 0xa3, 0xc9, 0xac, 0xdc,
+		0x40, 0xa3, 0xe4, 0x3d, 0xb7, 0x51, 0x08, 0x03, 0xc7, 0xe7, 0xaf, 0xef,
+		0x32, 0xcb, 0x43, 0x9a, 0x15, 0xaa, 0x0d, 0xd7, 0x48, 0x9b, 0x8c, 0xd1,
+		0x91, 0x08, 0x0b, 0x25, 0x6b, 0x4b, 0xdb, 0xf1, 0xda, 0xe0, 0xdc, 0x36,
+		0x0c, 0x23, 0x58, 0xc5, 0xd7, 0x36, 0x6a, 0xb9, 0x24, 0x79, 0x4e, 0x8e,
+		0x31, 0x83, 0xfd, 0xcc, 0xd4, 0x1f, 0x28, 0xde, 0xbd, 0xe1, 0xc9, 0x13,
+		0x18, 0xe6, 0x87, 0xfb, 0x9f, 0xef, 0x3d, 0xd5, 0x07, 0x52, 0x49, 0xcf,
+		0xed, 0xa8, 0xbb, 0x53, 0xe1, 0x41, 0x3e, 0xfa, 0xc7, 0x1f, 0x9d, 0xb6,
+		0x89, 0x83, 0x53, 0x14, 0xfd, 0x3d, 0x73, 0xdc, 0x76, 0x41, 0x79, 0xf4,
+		0xf7, 0x0c, 0xa2, 0x49, 0x04, 0x67, 0x41, 0x19, 0x67, 0x10, 0xfd, 0x60,
+		0x4d, 0x3d, 0x8b, 0xe0, 0xec, 0x67, 0x66, 0x56, 0xf9, 0xa2, 0x92, 0x52,
+		0x39, 0xb7, 0x48, 0x0f, 0x06, 0x3e, 0x31, 0x4c, 0x2d, 0xd1, 0xcc, 0x0a,
+		0xca, 0xf6, 0xde, 0x9a, 0x6d, 0x85, 0x49, 0x44, 0x22, 0x0d, 0xfc, 0xfe,
+		0x50, 0x80, 0xa8, 0x41, 0xf5, 0x16, 0x0b, 0x29, 0xca, 0x24, 0x3a, 0x14,
+		0x04, 0x75, 0xc1, 0x1a, 0xf4, 0x3b, 0x21, 0x7d, 0x88, 0x51, 0x7a, 0x34,
+		0x3e, 0xca, 0x62, 0xcd, 0xe3, 0xd4, 0x8a, 0x00, 0xc9, 0x81, 0x52, 0x9e,
+		0x3c, 0x81, 0xce, 0xd5, 0x48, 0xfd, 0x5e, 0x89, 0x47, 0x6c, 0x9f, 0xf8,
+		0x8e, 0x59, 0x74, 0x3a, 0xdd, 0xe9, 0xc6, 0x1d, 0x4e, 0xfe, 0x84, 0xd2,
+		0xab, 0x9f, 0x65, 0x89, 0xb3, 0x42, 0x0a, 0x81, 0x85, 0xc1, 0xf2, 0x09,
+		0x53, 0xc8, 0x9e, 0x57, 0xcd, 0x8a, 0xcd, 0xa6, 0xf9, 0xc5, 0xf7, 0xf6,
+		0xd3, 0x52, 0xb0, 0xaa, 0x8a, 0xc6, 0x5d, 0x98, 0x37, 0x75, 0x43, 0x11,
+		0x74, 0x32, 0x81, 0x5b, 0xc5, 0x36, 0xce, 0x37, 0x2b, 0xa6, 0x0d, 0x94,
+		0x6c, 0x0b, 0x72, 0x01, 0x6c, 0xcf, 0xd9, 0x28, 0xc8, 0x40, 0xc1, 0xc4,
+		0x9a, 0x51, 0xbe, 0x41, 0x31, 0x8f, 0xe8, 0x1b, 0xc9, 0x85, 0xd1, 0xe3,
+		0xc9, 0xc4, 0x15, 0x01, 0xd6, 0x6f, 0xb9, 0xd1, 0x94, 0x90, 0xe8, 0x06,
+		0x15, 0x2c, 0x78, 0x85, 0x30, 0xdf, 0x5a, 0xde, 0x1a, 0xd5, 0x1a, 0x15,
+		0x55, 0x7b, 0xa5, 0x62, 0x9b, 0x5b, 0xe2, 0x3d, 0x08, 0xd7, 0x81, 0xb5,
+		0x37, 0x03, 0x45, 0x90, 0xbe, 0xdc, 0x8b, 0x26, 0xe4, 0xf7, 0x93, 0xcf,
+		0xe8, 0x26, 0x0c, 0x21, 0x27, 0x22, 0x09, 0x66, 0xe7, 0xcf, 0xbe, 0x5b,
+		0x0d, 0xeb, 0x42, 0x1a, 0xec, 0xe2, 0x1a, 0x6d, 0x94, 0xc2, 0xdc, 0xc3,
+		0xcc, 0x2f, 0x85, 0x66, 0x78, 0x21, 0x85, 0x3d, 0x68, 0xe3, 0x67, 0x65,
+		0x4c, 0xc7, 0xa6, 0xdd, 0x4d, 0x6e, 0x61, 0xae, 0xe6, 0x54, 0xd8, 0x58,
+		0x16, 0xef, 0xa7, 0x1f, 0x72, 0xfa, 0xd7, 0xf5, 0x0d, 0xf8, 0x37, 0x64,
+		0x4e, 0xaf, 0xd5, 0xe6, 0xfd, 0xf4, 0x83, 0x3d, 0x11, 0x45, 0x5b, 0x55,
+		0x57, 0xb0, 0xb3, 0x1c, 0x0b, 0x73, 0x9f, 0x2f, 0xa8, 0xd2, 0x99, 0x41,
+		0x7c, 0xf1, 0x5d, 0x73, 0x0f, 0x9a, 0x09, 0x7d, 0xae, 0x51, 0xf1, 0x45,
+		0x7c, 0xe5, 0x43, 0xb2, 0xe3, 0x1a, 0x0e, 0xd9, 0xd9, 0x0c, 0xa6, 0x3e,
+		0x14, 0xdb, 0xc1, 0xbc, 0xaa, 0xde, 0x59, 0x21, 0x7f, 0x91, 0xce, 0x2c,
+		0x5e, 0xc0, 0x8d, 0xe2, 0xc6, 0xa0, 0x80, 0x2d, 0xd2, 0x51, 0xf1, 0x6c,
+		0x9a, 0xc1, 0xb7, 0x53, 0x3b, 0xa5, 0xb7, 0x32, 0xfd, 0xdc, 0x85, 0x35,
+		0xb1, 0x12, 0x66, 0xf0, 0xdd, 0x34, 0x83, 0x4d, 0xaf, 0x00, 0xbb, 0xc3,
+		0xe0, 0x1c, 0x9e, 0xc1, 0x53, 0x22, 0xc8, 0x60, 0xd5, 0xf7, 0xb9, 0x04,
+		0xbd, 0xef, 0x0c, 0xca, 0x59, 0x70, 0xa5, 0x8d, 0x2f, 0x0f, 0xf7, 0x95,
+		0xf2, 0x7e, 0xfa, 0xe1, 0xfd, 0xc5, 0x87, 0x0c, 0x2a, 0xf6, 0x39, 0x82,
+		0xe3, 0xa6, 0xb0, 0xe4, 0x73, 0xb8, 0xa0, 0xc1, 0x61, 0x92, 0x9a, 0x53,
+		0x89, 0x6a, 0x63, 0x41, 0xcd, 0x45, 0xce, 0x9a, 0xa6, 0xda, 0x26, 0xa4,
+		0xd4, 0x0c, 0x1e, 0xe7, 0x35, 0x6b, 0x92, 0x07, 0xcd, 0x40, 0xda, 0xef,
+		0x0c, 0x5a, 0xb3, 0xfb, 0x8e, 0x1d, 0xbb, 0xff, 0xb7, 0xd8, 0x91, 0xb5,
+		0x2c, 0xbb, 0x19, 0xd4, 0x9c, 0xf2, 0x73, 0xb0, 0x5f, 0xf4, 0x01, 0x67,
+		0x70, 0x61, 0x0b, 0x21, 0x3b, 0xe7, 0xfd, 0xd0, 0xc1, 0xcd, 0x90, 0x1d,
+		0x2b, 0xe1, 0x0c, 0x36, 0xf0, 0x14, 0x12, 0x03, 0xe7, 0x4e, 0x9f, 0x29,
+		0x4c, 0x3a, 0xf1, 0x92, 0x0b, 0xaf, 0xc2, 0xd0, 0x77, 0x05, 0xbb, 0xb0,
+		0x90, 0xed, 0x90, 0xe9, 0xfa, 0x88, 0x29, 0xe9, 0x71, 0x45, 0x8c, 0xd7,
+		0x70, 0x4e, 0x12, 0x11, 0xdb, 0x84, 0xe4, 0x73, 0x5f, 0x96, 0x51, 0xf0,
+		0x48, 0x5e, 0x55, 0x36, 0x7c, 0x12, 0x0e, 0xf3, 0xcd, 0xb7, 0xdf, 0x7e,
+		0x1b, 0x5f, 0x8d, 0x0f, 0xdc, 0x2d, 0xa4, 0x07, 0xd6, 0x33, 0x68, 0x82,
+		0x09, 0x3c, 0x4b, 0x8f, 0xa8, 0x6a, 0x76, 0x9f, 0x1b, 0xf9, 0x5a, 0x61,
+		0xc1, 0xa9, 0xa6, 0x4d, 0xbe, 0x4b, 0x33, 0x78, 0x66, 0x07, 0x9d, 0xa0,
+		0xe5, 0xe2, 0x33, 0xb4, 0x70, 0x06, 0xab, 0x8e, 0x5e, 0x1b, 0x25, 0x3f,
+		0xe2, 0x50, 0xba, 0x3f, 0xb1, 0xf9, 0x9f, 0x3a, 0x01, 0xe7, 0xb8, 0xe4,
+		0xe2, 0x35, 0x33, 0x2b, 0x57, 0x73, 0x87, 0xe2, 0xe0, 0xc8, 0xb7, 0x06,
+		0xe6, 0xe4, 0x19, 0x34, 0x7e, 0x47, 0x4d, 0x26, 0x50, 0x21, 0x5b, 0x23,
+		0x2c, 0x59, 0x43, 0xb1, 0x0a, 0x15, 0x82, 0x90, 0xdd, 0x96, 0xa2, 0x4f,
+		0xbf, 0xaf, 0x88, 0x9a, 0xcc, 0x4d, 0xf6, 0xb7, 0xc9, 0x05, 0x39, 0x60,
+		0xaf, 0x73, 0x67, 0x6a, 0x6b, 0xeb, 0x46, 0xe1, 0xfa, 0xb4, 0xc7, 0x73,
+		0xeb, 0xd9, 0x57, 0x81, 0x15, 0xa7, 0x2a, 0x61, 0x4a, 0x49, 0x0f, 0x0d,
+		0x39, 0xe0, 0x4b, 0x44, 0x76, 0x81, 0x94, 0x43, 0xbf, 0x93, 0xc9, 0x7d,
+		0xd2, 0xbc, 0xbf, 0xf8, 0x90, 0x66, 0xb0, 0xb5, 0x22, 0xa4, 0x76, 0xb5,
+		0x7b, 0x89, 0x98, 0xa5, 0xa6, 0x33, 0xe0, 0xf3, 0xd4, 0x14, 0x02, 0x0e,
+		0x14, 0x9b, 0x0c, 0x50, 0x86, 0x1e, 0xab, 0x70, 0x51, 0xda, 0x50, 0xf0,
+		0x1f, 0x84, 0x7e, 0x4a, 0x31, 0x6c, 0x00, 0x37, 0x94, 0x90, 0x67, 0x14,
+		0xd3, 0x0d, 0x2a, 0x90, 0xad, 0xa1, 0xb8, 0x4f, 0x1d, 0xb6, 0x7a, 0x22,
+		0x84, 0x85, 0x70, 0x17, 0x56, 0xd1, 0x51, 0xb0, 0x85, 0x15, 0x29, 0x98,
+		0x0e, 0x7e, 0xaa, 0xae, 0x28, 0xb5, 0x25, 0x4f, 0xca, 0x29, 0xfc, 0x3b,
+		0xac, 0xe7, 0x56, 0x7f, 0x3d, 0xd6, 0xf7, 0xbb, 0x96, 0xe2, 0x18, 0xe9,
+		0xa3, 0xe4, 0x89, 0x0b, 0x6e, 0x38, 0xab, 0x40, 0xb7, 0x45, 0x81, 0x5a,
+		0xd3, 0x54, 0x04, 0x1e, 0x51, 0xdd, 0xe7, 0xc7, 0x7f, 0x01, 0x11, 0x5c,
+		0x8a, 0xdc, 0x1d, 0x4a, 0xcf, 0x2d, 0x6c, 0x94, 0x74, 0xbb, 0x9b, 0x30,
+		0x9a, 0x52, 0x1b, 0x85, 0x84, 0xa7, 0x70, 0x9d, 0xc4, 0x97, 0x6b, 0xae,
+		0xf9, 0xbc, 0x22, 0xf8, 0xa0, 0x03, 0x81, 0xdc, 0x32, 0xde, 0x29, 0xec,
+		0x80, 0x9f, 0x07, 0x30, 0xc6, 0xc7, 0x49, 0xf4, 0x4d, 0xa9, 0x7d, 0x9e,
+		0xed, 0x8b, 0x62, 0x02, 0xd8, 0x7c, 0x1d, 0x45, 0x93, 0xbb, 0xc8, 0x46,
+		0x5c, 0x06, 0x8b, 0xc5, 0xe0, 0x19, 0x93, 0x09, 0xd4, 0xac, 0x01, 0x56,
+		0x55, 0x7d, 0x2e, 0x28, 0x85, 0x03, 0xd6, 0xc0, 0x96, 0x5a, 0xd6, 0x44,
+		0x9e, 0x94, 0x2f, 0x00, 0x2b, 0x0b, 0x02, 0x75, 0x36, 0xc1, 0x7b, 0xae,
+		0xe9, 0xe4, 0xfa, 0xbd, 0xd5, 0x06, 0xf4, 0x47, 0xde, 0x00, 0x37, 0xc1,
+		0x2f, 0x1f, 0x27, 0x04, 0xd7, 0x88, 0x38, 0xa5, 0xbd, 0x4a, 0x15, 0x39,
+		0x75, 0x8c, 0x82, 0x10, 0x90, 0xd8, 0x8a, 0x70, 0x10, 0x6e, 0x1e, 0x27,
+		0x54, 0x52, 0x7b, 0x0c, 0x23, 0x25, 0xa7, 0xf6, 0xb3, 0xf9, 0x4d, 0x91,
+		0x86, 0xb8, 0x4e, 0xee, 0x4e, 0xe5, 0xeb, 0xa7, 0xb0, 0x55, 0x04, 0x6e,
+		0xde, 0x48, 0x3a, 0x82, 0x48, 0xcd, 0x05, 0x53, 0x06, 0xf2, 0x00, 0x70,
+		0xc5, 0x69, 0x5e, 0x54, 0x64, 0xa9, 0x34, 0x57, 0xb6, 0x8e, 0x74, 0x25,
+		0x76, 0xdc, 0xf7, 0x93, 0x96, 0x46, 0xe1, 0x33, 0x71, 0xac, 0x32, 0xc0,
+		0x8a, 0x66, 0x18, 0xe5, 0x8d, 0xb2, 0x78, 0xe1, 0x3b, 0xe9, 0x39, 0xfb,
+		0x2a, 0x7b, 0xc1, 0x4a, 0xbc, 0x13, 0x49, 0x3a, 0xf6, 0xaa, 0x79, 0x5e,
+		0x96, 0xb0, 0x92, 0x6b, 0x54, 0x93, 0xa2, 0xe2, 0xc5, 0x47, 0x82, 0x48,
+		0x84, 0x81, 0x8a, 0x6b, 0x23, 0x50, 0x69, 0x0f, 0x55, 0x06, 0x7f, 0x9f,
+		0x63, 0xc1, 0x5a, 0x2a, 0x5e, 0xac, 0x56, 0xef, 0xa0, 0x94, 0x22, 0x36,
+		0xf0, 0x51, 0xc8, 0x0d, 0xfc, 0xfe, 0xdf, 0x2d, 0x61, 0x2b, 0x1b, 0xac,
+		0x2a, 0x40, 0x61, 0xb3, 0x7f, 0x23, 0x41, 0xcb, 0x6a, 0x4d, 0x9b, 0x89,
+		0xdb, 0x9d, 0xc3, 0xa0, 0xe4, 0x8b, 0x05, 0x12, 0x5c, 0xe9, 0x59, 0x6c,
+		0xd8, 0x16, 0x92, 0x4e, 0x52, 0xeb, 0xaf, 0x50, 0x49, 0xa9, 0xed, 0x26,
+		0x92, 0x1a, 0x3b, 0x41, 0x9c, 0xf0, 0x56, 0xd0, 0x43, 0xd7, 0x25, 0x90,
+		0x82, 0x66, 0x48, 0x7b, 0x1c, 0x22, 0x5e, 0x0a, 0x4b, 0xea, 0x75, 0xb4,
+		0xcb, 0xe0, 0xb3, 0x63, 0xf6, 0x74, 0x7b, 0x30, 0x2c, 0xf5, 0x52, 0xd2,
+		0x4e, 0x22, 0xe5, 0x64, 0xc0, 0xca, 0x12, 0x18, 0xc1, 0x08, 0xb6, 0xb4,
+		0x52, 0x02, 0x99, 0x59, 0xf5, 0x29, 0xa0, 0x2d, 0x51, 0x12, 0x2f, 0xab,
+		0x55, 0xe7, 0xb1, 0xac, 0xa4, 0xb7, 0x05, 0x05, 0x07, 0x1b, 0x14, 0x18,
+		0x58, 0x00, 0x96, 0xd2, 0x1f, 0xd4, 0x87, 0x7c, 0x5b, 0x9d, 0x59, 0x07,
+		0xf7, 0x08, 0x6e, 0x18, 0x6e, 0x24, 0x94, 0x92, 0xea, 0x66, 0x27, 0x3a,
+		0xd9, 0xa7, 0x76, 0x00, 0x32, 0xc6, 0x0a, 0xc9, 0x26, 0x04, 0x03, 0x13,
+		0xa0, 0xa0, 0x2d, 0xe8, 0xe5, 0x45, 0xb7, 0xff, 0x80, 0x14, 0x05, 0x42,
+		0x2d, 0x15, 0x06, 0x6e, 0x73, 0x5c, 0xb1, 0x35, 0x97, 0xad, 0xa2, 0x78,
+		0x21, 0xa4, 0xaa, 0x59, 0x05, 0xa5, 0x06, 0x53, 0xc6, 0x6e, 0x1d, 0x34,
+		0xe3, 0xbb, 0x57, 0xb7, 0xaf, 0xb2, 0xa1, 0xb1, 0x57, 0x72, 0xe3, 0xc5,
+		0xd8, 0xa2, 0x79, 0x34, 0x0e, 0x74, 0x04, 0x5a, 0x01, 0x13, 0x5b, 0x2b,
+		0x0d, 0x49, 0x6e, 0x54, 0xac, 0x9d, 0x7a, 0x2c, 0x7c, 0x04, 0x83, 0xb5,
+		0x12, 0xc9, 0x70, 0x01, 0xc9, 0x86, 0x09, 0x43, 0x4c, 0x3f, 0x22, 0x36,
+		0xf0, 0xeb, 0x5d, 0xe0, 0xa9, 0x79, 0xdd, 0x54, 0x68, 0x17, 0x42, 0x33,
+		0xd3, 0x30, 0x29, 0x2a, 0x1f, 0x53, 0x69, 0xa9, 0xb2, 0x41, 0x01, 0xcc,
+		0x00, 0xb3, 0xdc, 0xbd, 0x6d, 0xbb, 0xcd, 0xd4, 0x4d, 0x18, 0x07, 0x53,
+		0x53, 0x84, 0x0a, 0xcc, 0x89, 0x63, 0x21, 0x9b, 0x2d, 0x0d, 0xf6, 0xfb,
+		0xc8, 0xc9, 0x5e, 0x96, 0x16, 0x26, 0x87, 0x39, 0xae, 0x68, 0x4d, 0x64,
+		0x5c, 0x32, 0x8e, 0x6d, 0xdb, 0x30, 0xed, 0xa2, 0x86, 0xd5, 0xa8, 0xb7,
+		0x0b, 0xc5, 0x6b, 0xaa, 0x5f, 0x4e, 0xed, 0xe4, 0x52, 0xf7, 0x7b, 0xd9,
+		0x12, 0x9f, 0xde, 0xd0, 0x44, 0x36, 0x1e, 0xed, 0xe1, 0x69, 0x71, 0xaf,
+		0x30, 0xef, 0x93, 0x24, 0x35, 0x29, 0x54, 0xb6, 0xa6, 0xaf, 0xae, 0xa9,
+		0x22, 0xde, 0x20, 0x50, 0x2d, 0xd1, 0xfb, 0x21, 0xc8, 0x56, 0x69, 0xac,
+		0xa8, 0x2e, 0xa6, 0x4a, 0x00, 0x5c, 0xdd, 0xd0, 0x09, 0x6b, 0x07, 0x53,
+		0xf9, 0x0e, 0x30, 0x84, 0xa9, 0x79, 0xbd, 0x3c, 0x57, 0x92, 0x9c, 0xbb,
+		0x8c, 0x41, 0xab, 0x62, 0xf6, 0x8f, 0x28, 0x3a, 0xb3, 0x83, 0xf6, 0x6e,
+		0x7a, 0xee, 0xea, 0x65, 0xe2, 0x5b, 0xbb, 0x9d, 0x44, 0xd6, 0x4f, 0x22,
+		0x53, 0x5e, 0xda, 0x3c, 0x2d, 0x84, 0xf5, 0x34, 0x73, 0x74, 0x11, 0xa1,
+		0x3d, 0x91, 0xff, 0x18, 0x94, 0xb5, 0x61, 0xb4, 0x4d, 0xbe, 0x93, 0x14,
+		0x9e, 0xc2, 0x34, 0xff, 0xaf, 0x30, 0xa8, 0xc3, 0x2f, 0x32, 0x07, 0x59,
+		0x64, 0x10, 0xc8, 0x4f, 0x80, 0xf8, 0x69, 0x7a, 0x16, 0xfd, 0x23, 0xba,
+		0x89, 0x9c, 0x9e, 0x7c, 0x01, 0x3a, 0xb8, 0x37, 0x0a, 0x3b, 0x70, 0x34,
+		0x5c, 0xba, 0x4b, 0xef, 0x4f, 0xae, 0xde, 0xe3, 0xf3, 0xd1, 0xd9, 0x83,
+		0xd2, 0x9e, 0x45, 0x3d, 0x82, 0xff, 0xed, 0x74, 0x4a, 0xf0, 0xa8, 0xe3,
+		0x1b, 0x84, 0xd9, 0x0d, 0xd5, 0x4e, 0x77, 0x06, 0xfe, 0xba, 0x2f, 0x08,
+		0x7a, 0x62, 0x55, 0xe1, 0x72, 0x21, 0x9c, 0xb2, 0xa3, 0xd1, 0x60, 0x28,
+		0x00, 0xc0, 0x35, 0x83, 0x95, 0xc2, 0xc5, 0x2c, 0x8a, 0xcf, 0xbe, 0x34,
+		0xfc, 0x2c, 0x8e, 0xc0, 0x6c, 0x1b, 0x9c, 0x45, 0xf3, 0xd6, 0x18, 0x29,
+		0x22, 0xbf, 0xd6, 0x68, 0x6e, 0x04, 0xcc, 0x8d, 0x08, 0x20, 0x6c, 0x74,
+		0xe3, 0x2f, 0x30, 0xae, 0x27, 0xec, 0xc6, 0x8b, 0xe6, 0xc4, 0x36, 0x75,
+		0xe3, 0xd1, 0xd5, 0x60, 0xdb, 0xe0, 0xa7, 0xf6, 0x92, 0xc0, 0x9b, 0xf7,
+		0xba, 0xe4, 0xeb, 0xa0, 0xc6, 0xde, 0x6b, 0x3d, 0x3a, 0x8e, 0x2a, 0xbe,
+		0x89, 0x1c, 0xe1, 0x99, 0xf3, 0xce, 0xf0, 0x11, 0x0f, 0xd1, 0xe5, 0xc8,
+		0xe8, 0xb9, 0x11, 0x46, 0xca, 0x6a, 0xce, 0x54, 0x74, 0x13, 0x77, 0x44,
+		0x00, 0x03, 0xf6, 0x24, 0xf9, 0xf9, 0x52, 0xc9, 0xb6, 0x81, 0xee, 0xd7,
+		0xb9, 0xae, 0xf7, 0xe9, 0xf7, 0xf4, 0x73, 0xe0, 0xb8, 0x0f, 0x39, 0xac,
+		0x77, 0xd5, 0xde, 0xf9, 0xfa, 0x5f, 0x04, 0xff, 0x65, 0x5f, 0xf6, 0xc1,
+		0xaf, 0xd4, 0x77, 0x7c, 0x76, 0xe8, 0x9f, 0xf0, 0xc3, 0x00, 0xeb, 0xb5,
+		0x28, 0x79, 0x9c, 0xc2, 0x59, 0x1c, 0xdd, 0xbc, 0x2c, 0xb9, 0xb9, 0x9e,
+		0x38, 0xf3, 0xf5, 0x8b, 0xf4, 0x16, 0x3e, 0x5c, 0x33, 0x2f, 0xed, 0x54,
+		0x0e, 0x7a, 0x8e, 0xbc, 0x0a, 0xbe, 0x5a, 0xa4, 0x53, 0xa0, 0x75, 0xe2,
+		0xa4, 0x70, 0x1c, 0x8f, 0xe5, 0xf0, 0xc6, 0x19, 0x34, 0xf8, 0xcb, 0x81,
+		0x61, 0x43, 0x4f, 0xe0, 0x5d, 0x07, 0x45, 0x19, 0x82, 0xa1, 0x0d, 0xa3,
+		0xc9, 0xe0, 0x84, 0xfe, 0xd4, 0xed, 0xf2, 0x10, 0xb1, 0x61, 0xe7, 0x69,
+		0x87, 0xa8, 0x7f, 0x08, 0xb3, 0xdd, 0xa1, 0xdd, 0xe5, 0x36, 0x0f, 0x47,
+		0x80, 0x1e, 0x78, 0x49, 0x7a, 0xf7, 0x76, 0x7b, 0x36, 0x4e, 0xdf, 0x4f,
+		0x3f, 0x64, 0xf0, 0x80, 0x93, 0xf8, 0x39, 0x76, 0xdd, 0x51, 0xc2, 0xed,
+		0xa1, 0x6e, 0x8f, 0x5d, 0x28, 0xdd, 0x6d, 0x76, 0xe6, 0x6f, 0x7c, 0x81,
+		0xc1, 0x8a, 0x89, 0xb2, 0x42, 0x35, 0x94, 0xeb, 0x14, 0xe4, 0x4f, 0x68,
+		0xb2, 0xb6, 0x69, 0xea, 0xec, 0x21, 0x01, 0xdc, 0xfc, 0x74, 0x3f, 0xf3,
+		0x4d, 0x6f, 0xee, 0xf4, 0x54, 0xd6, 0xe1, 0xe3, 0xf4, 0xe3, 0xbc, 0x91,
+		0xda, 0x24, 0xd1, 0xc4, 0x49, 0x47, 0x35, 0xc4, 0x27, 0x9b, 0x37, 0x3b,
+		0x54, 0x8b, 0x4a, 0x92, 0x4b, 0xf0, 0xb3, 0xef, 0x20, 0xf5, 0xa3, 0x0e,
+		0x8a, 0x85, 0x1e, 0x3e, 0xf2, 0x3c, 0x97, 0x4a, 0x6e, 0xaa, 0xa4, 0x6b,
+		0x18, 0xd9, 0xeb, 0x9b, 0x4b, 0x88, 0xaf, 0xa9, 0x6a, 0x15, 0xcb, 0x9b,
+		0xdb, 0x97, 0x3f, 0xbd, 0x7c, 0x77, 0xf7, 0xcb, 0x5f, 0xe0, 0xf6, 0xf9,
+		0xbb, 0xe7, 0xf0, 0xf6, 0xd5, 0xaf, 0x6f, 0x5e, 0xbc, 0xbc, 0x9e, 0xf8,
+		0xce, 0xeb, 0xb9, 0x9a, 0xdc, 0x5c, 0xf3, 0x9b, 0xf8, 0xcc, 0xcd, 0x7b,
+		0x16, 0x5f, 0x4f, 0xb8, 0x6b, 0x8d, 0xfd, 0x09, 0x30, 0x1a, 0x8d, 0x6a,
+		0x77, 0x0f, 0x74, 0x09, 0xb1, 0xbd, 0x1e, 0xa1, 0x7a, 0xe6, 0x10, 0xfe,
+		0xdd, 0x48, 0xf5, 0x11, 0x4b, 0x7b, 0xa9, 0xc0, 0x0d, 0x28, 0x3c, 0x67,
+		0x4d, 0x83, 0x4c, 0x69, 0xe0, 0xc6, 0x72, 0xe3, 0x3a, 0xa4, 0xae, 0x47,
+		0x43, 0x1b, 0x25, 0xd7, 0x9c, 0x70, 0xd7, 0x8f, 0xd8, 0xd8, 0xfb, 0x03,
+		0xed, 0x2f, 0xd9, 0xdd, 0xb5, 0xa1, 0xce, 0xbd, 0x1b, 0x8f, 0x46, 0xbb,
+		0x6c, 0xb0, 0xcc, 0x6d, 0x83, 0x97, 0x10, 0xfb, 0x52, 0x8b, 0x6e, 0x80,
+		0xb1, 0x62, 0xdb, 0x4b, 0xf8, 0x7e, 0x3a, 0x9d, 0x66, 0x50, 0xcb, 0x56,
+		0xe3, 0x6f, 0x94, 0x80, 0x5e, 0x42, 0xdc, 0xb0, 0xf6, 0x5f, 0x74, 0x5f,
+		0x22, 0x17, 0x0b, 0x8d, 0xe6, 0x12, 0xfe, 0xf4, 0x7d, 0xc7, 0xd0, 0x3b,
+		0x91, 0x73, 0xa0, 0x3e, 0x19, 0xb2, 0x79, 0x60, 0x87, 0x7b, 0x77, 0x75,
+		0x8c, 0xcf, 0x6c, 0x8c, 0xba, 0x5c, 0x31, 0x9d, 0x98, 0xf2, 0x32, 0x5c,
+		0x3d, 0x26, 0x51, 0xaf, 0xc0, 0x28, 0x4d, 0x07, 0x99, 0x8e, 0x1f, 0xe6,
+		0x77, 0x8f, 0xcd, 0xfe, 0x79, 0xf5, 0xff, 0xde, 0x9a, 0x25, 0x2f, 0xed,
+		0xcb, 0x03, 0xb2, 0xaa, 0x1d, 0xfe, 0x5a, 0x6a, 0x5b, 0x72, 0x12, 0xb2,
+		0xaa, 0xa5, 0xd0, 0xd7, 0x93, 0xb9, 0xba, 0xb9, 0x6e, 0xab, 0x9b, 0xeb,
+		0x8a, 0xdf, 0xbc, 0x46, 0xb5, 0x22, 0xec, 0x82, 0x0c, 0x4b, 0xb7, 0x7f,
+		0x50, 0x4a, 0xd4, 0x76, 0xbc, 0x2d, 0xf1, 0xae, 0x27, 0x15, 0x0f, 0x74,
+		0x35, 0xd7, 0x04, 0xca, 0x68, 0xba, 0x83, 0x98, 0x57, 0x58, 0x83, 0x2b,
+		0x79, 0xcf, 0x35, 0x2f, 0xd1, 0xd1, 0x4d, 0xda, 0xea, 0xe6, 0x4b, 0x06,
+		0xf7, 0x57, 0x98, 0xff, 0xae, 0xbd, 0x3b, 0x83, 0xf8, 0x54, 0x74, 0xe8,
+		0x00, 0x94, 0x37, 0x53, 0x4e, 0x4b, 0x4f, 0x36, 0xba, 0x73, 0xd4, 0x53,
+		0x3d, 0x90, 0xd8, 0x7a, 0x2a, 0x5f, 0x95, 0x86, 0xab, 0xa8, 0xc1, 0xcc,
+		0xbb, 0x71, 0xff, 0x31, 0x99, 0xf8, 0x97, 0x26, 0x10, 0xdd, 0x32, 0x83,
+		0x11, 0x14, 0xb2, 0x6a, 0x6b, 0xe1, 0xd2, 0xf5, 0x55, 0x5b, 0x33, 0xc1,
+		0xff, 0x15, 0x64, 0x30, 0xac, 0x6e, 0x34, 0x0d, 0x72, 0x55, 0x5e, 0x12,
+		0xd1, 0x8d, 0x7e, 0xee, 0x1f, 0x04, 0x50, 0x48, 0x72, 0xbf, 0xfa, 0xe2,
+		0xdf, 0x2e, 0x95, 0x56, 0x79, 0xe0, 0x71, 0x07, 0x60, 0xc3, 0x9d, 0x7f,
+		0xa5, 0xe2, 0x06, 0x16, 0x52, 0x68, 0x59, 0x61, 0x5e, 0xc9, 0x65, 0x02,
+		0xd1, 0xcb, 0x37, 0x6f, 0x5e, 0xbd, 0xb9, 0x84, 0x17, 0xb2, 0xad, 0x9c,
+		0x3b, 0x34, 0xa8, 0x16, 0x52, 0xd5, 0x01, 0xcc, 0x00, 0x85, 0xff, 0x6c,
+		0x51, 0x9b, 0x1c, 0xde, 0x5a, 0x5e, 0x50, 0xca, 0x8d, 0xf8, 0x21, 0x02,
+		0x3f, 0xb3, 0x83, 0xf3, 0xff, 0xca, 0xb5, 0x91, 0x4b, 0xc5, 0x6a, 0x02,
+		0x72, 0x0a, 0xfb, 0x54, 0x41, 0x03, 0xa1, 0xf1, 0x2b, 0xd9, 0xd2, 0x85,
+		0xef, 0x4a, 0x6e, 0x04, 0xb0, 0x79, 0xd8, 0x78, 0x15, 0xd7, 0x66, 0x3c,
+		0xc4, 0x33, 0x44, 0x79, 0x0c, 0xcd, 0x0c, 0xb1, 0x78, 0x43, 0x97, 0x36,
+		0x7a, 0xf2, 0xc3, 0xbc, 0x2d, 0x3e, 0xa2, 0x99, 0x11, 0xd7, 0x21, 0x36,
+		0xd3, 0x6f, 0xb0, 0x1e, 0x77, 0xbd, 0x38, 0x40, 0xe9, 0xf2, 0x1f, 0xed,
+		0xd8, 0x21, 0xdc, 0xca, 0x33, 0x98, 0xf7, 0x58, 0x6a, 0x87, 0x84, 0xd6,
+		0xec, 0x3e, 0x83, 0x79, 0xfe, 0x82, 0x50, 0x93, 0x34, 0xe0, 0xe9, 0xc4,
+		0xd8, 0x04, 0x41, 0xc9, 0x3f, 0xdc, 0x47, 0x9c, 0xe6, 0x58, 0x37, 0x66,
+		0x9b, 0xa4, 0x5f, 0x3d, 0x1d, 0xd9, 0xf7, 0x71, 0xb2, 0x97, 0xc8, 0xcd,
+		0x99, 0xa2, 0x64, 0x96, 0x4e, 0xef, 0x28, 0x9c, 0xd6, 0x54, 0x96, 0xb8,
+		0x64, 0x37, 0xce, 0x1c, 0x4a, 0x6b, 0x33, 0xe6, 0xe4, 0x62, 0x3a, 0x85,
+		0xa7, 0x41, 0x3c, 0x20, 0x78, 0xe6, 0x9e, 0xee, 0x21, 0xe2, 0xff, 0xe8,
+		0xaa, 0x9a, 0xbd, 0xfb, 0xfd, 0x40, 0x48, 0x09, 0x84, 0x33, 0x4c, 0x49,
+		0x55, 0x1c, 0x3d, 0x71, 0xa1, 0x47, 0x1b, 0xe4, 0x92, 0xc9, 0x3c, 0xa7,
+		0xc7, 0x57, 0x69, 0x6e, 0xa4, 0x4d, 0xe3, 0xf1, 0xad, 0x51, 0x5c, 0x2c,
+		0x93, 0x34, 0x30, 0x6c, 0x3c, 0xc0, 0xe1, 0x96, 0x9c, 0x5e, 0xf5, 0x8e,
+		0x17, 0xd0, 0x3c, 0x82, 0xa4, 0x60, 0xcd, 0x71, 0x43, 0x4f, 0x36, 0xa8,
+		0x8c, 0xde, 0x0c, 0x23, 0x0d, 0x55, 0xdc, 0x15, 0x5d, 0xac, 0xb7, 0x8d,
+		0x7f, 0x53, 0xc5, 0x55, 0x78, 0x5b, 0xb2, 0xac, 0x51, 0x18, 0x9d, 0x81,
+		0x96, 0xc4, 0x67, 0xd5, 0x8a, 0x52, 0x61, 0xa9, 0xc9, 0x8d, 0x28, 0x14,
+		0x71, 0xb1, 0xa4, 0xab, 0xce, 0xaa, 0x62, 0x8d, 0xb6, 0x8f, 0xbc, 0x24,
+		0xbd, 0x9b, 0x00, 0x02, 0x1f, 0xc3, 0x2d, 0xb8, 0x05, 0xb7, 0x2c, 0xc8,
+		0x47, 0xe0, 0xd9, 0x2b, 0xaa, 0x52, 0xdd, 0x93, 0x40, 0xdf, 0xf2, 0x8b,
+		0x2c, 0x71, 0xe8, 0x5b, 0x42, 0x96, 0xd8, 0x41, 0x7f, 0x15, 0xb7, 0xd6,
+		0x8c, 0x28, 0x70, 0xd9, 0xc8, 0x64, 0x5f, 0xcf, 0x50, 0x8f, 0x7b, 0x5f,
+		0xe5, 0x7b, 0x87, 0x2f, 0x33, 0x68, 0x96, 0xdf, 0x5c, 0xef, 0xe0, 0x7d,
+		0x46, 0xaf, 0xa4, 0x8a, 0x13, 0x8b, 0x93, 0xa3, 0x68, 0xc9, 0xc3, 0x31,
+		0x16, 0xc7, 0x22, 0x79, 0x2c, 0x88, 0xf5, 0x20, 0x8f, 0x39, 0x2b, 0x97,
+		0x9f, 0x19, 0x6f, 0x6d, 0x7c, 0x92, 0xc1, 0xa9, 0x97, 0x45, 0x37, 0xdd,
+		0x53, 0xa1, 0x03, 0x67, 0x71, 0xbc, 0xfa, 0x47, 0x45, 0x7b, 0x53, 0x0c,
+		0x9b, 0xf7, 0x27, 0x72, 0xd7, 0x90, 0x6e, 0xf0, 0x8a, 0x57, 0xa5, 0x42,
+		0xff, 0x84, 0x88, 0x14, 0xd9, 0x56, 0x5e, 0x89, 0x74, 0x8e, 0x50, 0xdc,
+		0x3f, 0x56, 0x56, 0xd8, 0x37, 0x7b, 0x1c, 0xf6, 0x37, 0x4e, 0x41, 0xad,
+		0x7e, 0xf3, 0x0c, 0x0c, 0x9b, 0xb8, 0xf6, 0x9e, 0x61, 0x5b, 0xf5, 0xee,
+		0xe9, 0x83, 0x3d, 0xd2, 0x2b, 0x38, 0xff, 0x5e, 0x89, 0x66, 0xd0, 0xc0,
+		0x94, 0x83, 0x33, 0x32, 0xeb, 0xac, 0x64, 0x1a, 0x0a, 0x76, 0x04, 0xf4,
+		0x50, 0xb6, 0x2a, 0xc8, 0x47, 0xe9, 0x79, 0xcd, 0xd6, 0x07, 0x6e, 0xbf,
+		0x10, 0x1a, 0xe1, 0xee, 0xa7, 0x83, 0xa3, 0xbd, 0x27, 0x76, 0x39, 0xdd,
+		0x09, 0x38, 0x44, 0x9d, 0xaa, 0x16, 0x9a, 0xbd, 0xad, 0xc2, 0xf3, 0x3c,
+		0x1a, 0x63, 0x45, 0x71, 0xdf, 0x4e, 0x9d, 0xd4, 0x48, 0x45, 0xc8, 0x3f,
+		0xda, 0x67, 0xdf, 0xff, 0xf8, 0xd2, 0x56, 0x20, 0xf6, 0xe7, 0x9f, 0x63,
+		0x4b, 0x5a, 0xf1, 0xbc, 0xf0, 0x3a, 0x48, 0xe2, 0x7c, 0xe0, 0x6e, 0x19,
+		0xe4, 0xbd, 0x1b, 0x9d, 0x4c, 0x41, 0x7b, 0xe5, 0x1c, 0x89, 0x07, 0x8f,
+		0x4e, 0xf7, 0xd0, 0x8c, 0x03, 0x81, 0x4f, 0x13, 0x79, 0x08, 0x74, 0xb0,
+		0x86, 0xd3, 0x74, 0x5f, 0x58, 0x95, 0xbf, 0x1c, 0x74, 0xf8, 0x42, 0x1f,
+		0x41, 0xba, 0xab, 0xe1, 0x8a, 0x5f, 0xf9, 0xc7, 0x35, 0x3d, 0xc8, 0xfd,
+		0xd0, 0x91, 0x80, 0x93, 0x1f, 0x56, 0x74, 0xf0, 0xa8, 0xed, 0xec, 0x62,
+		0x78, 0x1c, 0x28, 0x29, 0x4d, 0x7f, 0x1c, 0xd0, 0xa2, 0x7d, 0xcc, 0x76,
+		0x00, 0xbb, 0x53, 0xe3, 0xc9, 0xc8, 0x4d, 0x23, 0x3b, 0x0f, 0x3c, 0xf5,
+		0xd4, 0xeb, 0x6b, 0xfd, 0x90, 0x66, 0x18, 0x78, 0x62, 0x38, 0xcf, 0x3d,
+		0xc4, 0x0f, 0x5f, 0x38, 0xd7, 0x7d, 0x50, 0xed, 0x9e, 0x78, 0x9e, 0x56,
+		0x84, 0x5d, 0x0c, 0x1d, 0xa3, 0x71, 0x3a, 0x7c, 0x0a, 0xba, 0x77, 0x8b,
+		0x70, 0xd0, 0x4e, 0xad, 0x3f, 0xda, 0xe2, 0x36, 0x3c, 0x03, 0xfc, 0xc2,
+		0x9d, 0x03, 0x59, 0xf2, 0x27, 0x62, 0x4f, 0xde, 0x49, 0x22, 0xc4, 0xe1,
+		0xa5, 0xe0, 0x17, 0x06, 0x75, 0x59, 0x47, 0x6f, 0x42, 0x3b, 0xfb, 0xce,
+		0x9f, 0x12, 0x61, 0x0d, 0x16, 0xee, 0xd6, 0x7c, 0x29, 0x98, 0xbb, 0x55,
+		0xd0, 0x86, 0x99, 0xd6, 0xc6, 0x7c, 0xff, 0xa0, 0x80, 0xaf, 0xe9, 0x69,
+		0x9b, 0x91, 0xe1, 0xed, 0x82, 0x4f, 0x20, 0xc7, 0x07, 0xb7, 0x27, 0x27,
+		0xd4, 0x12, 0x7d, 0xe3, 0x08, 0x0a, 0x29, 0xa2, 0x21, 0x50, 0xed, 0x1e,
+		0xc2, 0x85, 0x3a, 0x22, 0xbd, 0x3a, 0xa6, 0xdd, 0xc3, 0x0a, 0xf7, 0x1e,
+		0xd2, 0x05, 0x6a, 0xca, 0x08, 0x0f, 0x09, 0x8d, 0x62, 0x82, 0x1e, 0x8d,
+		0xa2, 0x30, 0xa4, 0xa1, 0xdd, 0xf8, 0x28, 0xe7, 0x7a, 0x50, 0xc8, 0x13,
+		0x13, 0x7f, 0x49, 0xce, 0xc3, 0x35, 0x9d, 0x16, 0xb2, 0xa7, 0x3a, 0x92,
+		0x90, 0xce, 0xd9, 0xb7, 0x86, 0x29, 0xaa, 0x09, 0x32, 0x78, 0x6b, 0x64,
+		0xd3, 0x50, 0xad, 0x47, 0x78, 0xac, 0xf5, 0x18, 0xfa, 0x20, 0x9c, 0xda,
+		0xe7, 0x7d, 0xe1, 0x6e, 0x82, 0x86, 0x39, 0x39, 0xec, 0x61, 0xab, 0x89,
+		0xc3, 0xd1, 0xda, 0xf8, 0x22, 0x79, 0x14, 0x1e, 0x3b, 0x77, 0xde, 0xe0,
+		0x9f, 0x3e, 0x83, 0x46, 0x13, 0x1e, 0xb4, 0xef, 0x85, 0xad, 0xce, 0x61,
+		0x6e, 0x75, 0x92, 0x5e, 0xed, 0x32, 0x38, 0xf1, 0x32, 0x3e, 0x0d, 0xb7,
+		0x59, 0x2b, 0x5e, 0x7a, 0x2f, 0x0e, 0x07, 0x60, 0xfc, 0x9a, 0xee, 0x4a,
+		0xe2, 0xf4, 0x24, 0x01, 0x2d, 0x28, 0xe8, 0xd6, 0xa2, 0x42, 0xbd, 0xb6,
+		0xbe, 0x82, 0x7a, 0x68, 0x87, 0x9d, 0x37, 0xae, 0x91, 0xcd, 0xd1, 0xb2,
+		0x8b, 0x0a, 0x99, 0xea, 0xd6, 0xd6, 0x29, 0xe0, 0x6a, 0x3c, 0x5c, 0x7e,
+		0x87, 0x6e, 0x5d, 0x8d, 0x4f, 0x4c, 0x6d, 0x57, 0x62, 0xdd, 0xda, 0x3e,
+		0x0c, 0x1e, 0x7f, 0x85, 0x74, 0x07, 0x96, 0xff, 0xea, 0xa5, 0xd8, 0x75,
+		0x74, 0xf9, 0xcd, 0xfe, 0x4a, 0x3c, 0x68, 0xe2, 0x65, 0x3e, 0x78, 0xda,
+		0xe6, 0x4b, 0x0a, 0xb2, 0xbc, 0xdb, 0xd7, 0xfd, 0xa5, 0xb0, 0xd7, 0x4c,
+		0xbf, 0xdd, 0xe9, 0xe8, 0xa7, 0x9b, 0xd2, 0xf0, 0x0a, 0xee, 0x6a, 0xfc,
+		0x38, 0x29, 0x65, 0xd1, 0x52, 0xba, 0x47, 0x7b, 0x88, 0x95, 0xdb, 0x83,
+		0xb3, 0x8b, 0xa6, 0x7e, 0x44, 0x43, 0x52, 0x62, 0xe8, 0xc7, 0xba, 0x23,
+		0xd5, 0x4d, 0xdc, 0xfd, 0x8f, 0x8d, 0x24, 0xdd, 0x93, 0x64, 0x7c, 0xca,
+		0x96, 0x47, 0x68, 0x57, 0x1f, 0xb3, 0xfb, 0xb0, 0xe8, 0xa3, 0x33, 0x0d,
+		0xee, 0xa3, 0xe3, 0x57, 0x0c, 0xee, 0x6f, 0x60, 0x4f, 0x1f, 0x6b, 0x7d,
+		0x0c, 0x24, 0x97, 0x3e, 0x88, 0x89, 0xa2, 0x74, 0x6d, 0x5f, 0xd8, 0x0e,
+		0x83, 0x95, 0xd2, 0x7e, 0x98, 0x3c, 0x85, 0x0b, 0xa8, 0xb9, 0x68, 0x0d,
+		0xd2, 0x0b, 0xc4, 0x8b, 0xa7, 0xff, 0x39, 0x7d, 0x7a, 0x31, 0x9d, 0xba,
+		0x57, 0x37, 0x0f, 0xee, 0xaa, 0x30, 0xe5, 0x43, 0x9c, 0x76, 0xe3, 0x5d,
+		0x7a, 0x35, 0xfe, 0xdf, 0x01, 0x00, 0xdd, 0xb7, 0x88, 0x03, 0x56, 0x33,
+		0x00, 0x00,
 	},
 		"assets/static/js/graphite-news.js",
 	)
@@ -0,0 +1,181 @@
+package main
+
+// Spots data source names with IDs in them: UUIDs, hashes, IP addresses,
+// timestamps or long numbers as a path segment. Every such name gets a new
+// metric for every request/host/day, which is how a Graphite cluster fills
+// its disks. Names are reduced to a pattern (app.requests.<uuid>.latency)
+// and tallied, the report is served on /cardinality/.
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type (
+	// Tally of all data sources that reduce to the same pattern
+	cardinalityEntry struct {
+		Pattern  string   // app.requests.<uuid>.latency
+		Prefix   string   // part before the first ID, app.requests
+		Kinds    []string // kinds of ID found, f.ex. uuid
+		Count    int
+		Examples []string
+		LastSeen time.Time
+	}
+
+	cardinalityReport struct {
+		*sync.RWMutex
+		entries map[string]*cardinalityEntry
+	}
+)
+
+const (
+	// Number of patterns to keep track of, the least recently seen get dropped
+	maxCardinalityPatterns = 1000
+
+	// Number of example names to keep for every pattern
+	cardinalityExamples = 3
+)
+
+var (
+	idKinds = []struct {
+		kind string
+		re   *regexp.Regexp
+	}{
+		{"uuid", regexp.MustCompile(`^(?i)[0-9a-f]{8}[-_]?[0-9a-f]{4}[-_]?[0-9a-f]{4}[-_]?[0-9a-f]{4}[-_]?[0-9a-f]{12}$`)},
+		{"ip", regexp.MustCompile(`^[0-9]{1,3}([-_])[0-9]{1,3}([-_])[0-9]{1,3}([-_])[0-9]{1,3}$`)},
+		{"timestamp", regexp.MustCompile(`^(1[0-9]{9}|1[0-9]{12}|(19|20)[0-9]{2}[-_]?[01][0-9][-_]?[0-3][0-9]([tT_-]?[0-2][0-9][-_:]?[0-5][0-9]([-_:]?[0-5][0-9])?)?)$`)},
+		{"hex", regexp.MustCompile(`^(?i)(0x)?[0-9a-f]{12,}$`)},
+		{"number", regexp.MustCompile(`^[0-9]{6,}$`)},
+	}
+	octet = regexp.MustCompile(`^(25[0-5]|2[0-4][0-9]|1?[0-9]{1,2})$`)
+
+	cardinality = &cardinalityReport{&sync.RWMutex{}, map[string]*cardinalityEntry{}}
+)
+
+// Returns the kind of ID a single path segment looks like, or "" if it
+// looks like a regular name
+func idKind(segment string) string {
+	for _, k := range idKinds {
+		if k.re.MatchString(segment) {
+			// a hex string of just letters is a word, like "deadbeefcafe"
+			if k.kind == "hex" && !strings.ContainsAny(segment, "0123456789") {
+				continue
+			}
+			return k.kind
+		}
+	}
+	return ""
+}
+
+// Reduces a name to a pattern with every ID-like segment replaced by its
+// kind, f.ex. app.requests.<uuid>.latency. Returns "" if there are none.
+// An IP address written with dots takes 4 segments and becomes one <ip>.
+func idPattern(name string) (pattern string, prefix string, kinds []string) {
+	segments := strings.Split(name, ".")
+	var out []string
+	for i := 0; i < len(segments); i++ {
+		kind := idKind(segments[i])
+		if len(kind) == 0 && i+3 < len(segments) && octet.MatchString(segments[i]) &&
+			octet.MatchString(segments[i+1]) && octet.MatchString(segments[i+2]) && octet.MatchString(segments[i+3]) {
+			kind = "ip"
+			i += 3
+		}
+		if len(kind) == 0 {
+			out = append(out, segments[i])
+			continue
+		}
+		if len(kinds) == 0 {
+			prefix = strings.Join(out, ".")
+		}
+		kinds = append(kinds, kind)
+		out = append(out, "<"+kind+">")
+	}
+	if len(kinds) == 0 {
+		return "", "", nil
+	}
+	return strings.Join(out, "."), prefix, kinds
+}
+
+// Tallies a data source, if its name has any IDs in it
+func (cr *cardinalityReport) add(ds Datasource) {
+	pattern, prefix, kinds := idPattern(ds.Name)
+	if len(pattern) == 0 {
+		return
+	}
+	cr.Lock()
+	defer cr.Unlock()
+
+	e, ok := cr.entries[pattern]
+	if !ok {
+		if len(cr.entries) >= maxCardinalityPatterns {
+			cr.dropLeastRecent()
+		}
+		e = &cardinalityEntry{Pattern: pattern, Prefix: prefix, Kinds: kinds}
+		cr.entries[pattern] = e
+	}
+	e.Count++
+	e.LastSeen = time.Now()
+	if len(e.Examples) < cardinalityExamples {
+		e.Examples = append(e.Examples, ds.Name)
+	}
+}
+
+// Drops the pattern that wasn't seen for the longest time. Not the least
+// counted, that would always be the newest one, which never gets a chance
+// to add up.
+func (cr *cardinalityReport) dropLeastRecent() {
+	var least *cardinalityEntry
+	for _, e := range cr.entries {
+		if least == nil || e.LastSeen.Before(least.LastSeen) {
+			least = e
+		}
+	}
+	if least != nil {
+		delete(cr.entries, least.Pattern)
+	}
+}
+
+// Returns all patterns seen at least min times, most seen first
+func (cr *cardinalityReport) list(min int) []cardinalityEntry {
+	cr.RLock()
+	defer cr.RUnlock()
+
+	result := []cardinalityEntry{}
+	for _, e := range cr.entries {
+		if e.Count >= min {
+			result = append(result, *e)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].Pattern < result[j].Pattern
+	})
+	return result
+}
+
+// Tallies every new data source
+func trackCardinality() {
+	for ds := range subscribe() {
+		cardinality.add(ds)
+	}
+}
+
+// Serves the report, optionally only patterns seen ?min= times
+func cardinalityHandler(w http.ResponseWriter, r *http.Request) {
+	min, _ := strconv.Atoi(r.URL.Query().Get("min"))
+	js, err := json.Marshal(cardinality.list(min))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(js)
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestIdPattern(t *testing.T) {
+	type testpair struct {
+		name    string
+		pattern string
+		prefix  string
+	}
+
+	var testCases = []testpair{
+		{"app.requests.3f2504e0-4f89-11d3-9a0c-0305e82c3301.latency", "app.requests.<uuid>.latency", "app.requests"},
+		{"app.requests.3F2504E04F8911D39A0C0305E82C3301.latency", "app.requests.<uuid>.latency", "app.requests"},
+		{"servers.10_0_0_12.cpu", "servers.<ip>.cpu", "servers"},
+		{"servers.10.0.0.12.cpu", "servers.<ip>.cpu", "servers"},
+		{"jobs.1410649856.duration", "jobs.<timestamp>.duration", "jobs"},
+		{"reports.2014-09-13.count", "reports.<timestamp>.count", "reports"},
+		{"cache.da39a3ee5e6b4b0d3255bfef95601890afd80709.hits", "cache.<hex>.hits", "cache"},
+		{"orders.12345678.total", "orders.<number>.total", "orders"},
+		{"users.123456.sessions.deadbeef0001", "users.<number>.sessions.<hex>", "users"},
+		{"mac-mini_local.collectd.df-Volumes-Media.df_complex-free", "", ""},
+		{"app.http.status.404.count", "", ""},
+		{"app.deadbeefcafebabe.count", "", ""},
+	}
+	for _, test := range testCases {
+		pattern, prefix, _ := idPattern(test.name)
+		if pattern != test.pattern || prefix != test.prefix {
+			t.Fatal(fmt.Sprintf("Expected [%v] to become [%v] (prefix [%v]), got [%v] (prefix [%v])", test.name, test.pattern, test.prefix, pattern, prefix))
+		}
+	}
+}
+
+func TestCardinalityReport(t *testing.T) {
+	saved := cardinality
+	cardinality = &cardinalityReport{&sync.RWMutex{}, map[string]*cardinalityEntry{}}
+	defer func() { cardinality = saved }()
+
+	cardinality.add(Datasource{Name: "cardtest.requests.3f2504e0-4f89-11d3-9a0c-0305e82c3301.latency"})
+	cardinality.add(Datasource{Name: "cardtest.requests.3f2504e0-4f89-11d3-9a0c-0305e82c3302.latency"})
+	cardinality.add(Datasource{Name: "cardtest.requests.regular.latency"})
+
+	req, _ := http.NewRequest("GET", "/cardinality/?min=2", nil)
+	w := httptest.NewRecorder()
+	cardinalityHandler(w, req)
+	var report []cardinalityEntry
+	json.Unmarshal(w.Body.Bytes(), &report)
+	if len(report) != 1 || report[0].Pattern != "cardtest.requests.<uuid>.latency" || report[0].Prefix != "cardtest.requests" || report[0].Count != 2 {
+		t.Fatal(fmt.Sprintf("Cardinality report does not list the pattern: %v", w.Body.String()))
+	}
+
+	State.Vals = nil
+	defer func() { State.Vals = nil }()
+	addItemToState(Datasource{Name: "cardtest.jobs.1410649856.duration"})
+	if ds := getDSbyName("cardtest.jobs.1410649856.duration"); ds.IdPattern != "cardtest.jobs.<timestamp>.duration" {
+		t.Fatal(fmt.Sprintf("Data source in state was not flagged as high cardinality: %+v", ds))
+	}
+}
+
+func TestCardinalityDropsLeastRecent(t *testing.T) {
+	cr := &cardinalityReport{&sync.RWMutex{}, map[string]*cardinalityEntry{}}
+	for i := 0; i < maxCardinalityPatterns; i++ {
+		cr.add(Datasource{Name: fmt.Sprintf("cardtest.app%v.3f2504e0-4f89-11d3-9a0c-0305e82c3301.latency", i)})
+	}
+	// the oldest pattern is the busiest one, all others were seen later
+	first := "cardtest.app0.<uuid>.latency"
+	cr.entries[first].Count = 100
+	cr.entries[first].LastSeen = time.Now().Add(-time.Hour)
+
+	// a new pattern pushes out the least recently seen one, and sticks
+	for i := 0; i < 2; i++ {
+		cr.add(Datasource{Name: "cardtest.new.3f2504e0-4f89-11d3-9a0c-0305e82c3301.latency"})
+	}
+	if _, ok := cr.entries[first]; ok || len(cr.entries) != maxCardinalityPatterns {
+		t.Fatal(fmt.Sprintf("Expected %v to be dropped, have %v patterns", first, len(cr.entries)))
+	}
+	if e, ok := cr.entries["cardtest.new.<uuid>.latency"]; !ok || e.Count != 2 {
+		t.Fatal(fmt.Sprintf("Expected the new pattern to add up, got %+v", e))
+	}
+}
@@ -0,0 +1,150 @@
+package main
+
+// Posts a digest of new data sources to Slack style incoming webhooks
+// (Mattermost, Rocket.Chat, etc understand the same format). Routes are
+// configured in a JSON file (-cw), checked in order, first match wins:
+//
+//	[{"Prefixes": ["app.payments"], "URL": "https://hooks.slack.com/...", "Channel": "#payments"},
+//	 {"URL": "https://hooks.slack.com/...", "Window": "10m"}]
+//
+// A route without Prefixes catches everything, so put it last.
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+	"time"
+)
+
+type (
+	// Where to post the digest for a set of prefixes
+	chatRoute struct {
+		Prefixes []string // graphite style globs, see matchesPrefix
+		URL      string   // incoming webhook URL
+		Channel  string   // overrides the channel of the webhook, if set
+		Window   string   // collect data sources this long before posting, defaults to 1m
+		Depth    int      // number of name segments to group on, defaults to 3
+		Format   string   // "slack" (default) or "markdown" for links
+		Retries  int
+	}
+
+	// Incoming webhook message, compatible with Slack and Mattermost
+	chatMessage struct {
+		Text     string `json:"text"`
+		Channel  string `json:"channel,omitempty"`
+		Username string `json:"username"`
+	}
+)
+
+const (
+	defaultChatWindow = time.Minute
+	defaultChatDepth  = 3
+
+	// Number of example names listed per group
+	chatExamples = 3
+)
+
+func loadChatRoutes(file string) ([]chatRoute, error) {
+	var routes []chatRoute
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &routes); err != nil {
+		return nil, fmt.Errorf("Could not parse %v: %v", file, err)
+	}
+	for i, route := range routes {
+		if len(route.URL) == 0 {
+			return nil, fmt.Errorf("Chat route without URL in %v", file)
+		}
+		if _, err := route.window(); err != nil {
+			return nil, fmt.Errorf("Invalid Window for %v: %v", redactURL(route.URL), err)
+		}
+		if route.Depth < 1 {
+			routes[i].Depth = defaultChatDepth
+		}
+	}
+	return routes, nil
+}
+
+func (route chatRoute) window() (time.Duration, error) {
+	if len(route.Window) == 0 {
+		return defaultChatWindow, nil
+	}
+	return time.ParseDuration(route.Window)
+}
+
+// Returns the index of the first route that wants a data source, or -1
+func routeFor(routes []chatRoute, name string) int {
+	for i, route := range routes {
+		if matchesAnyPrefix(route.Prefixes, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Loads the chat routes and starts routing new data sources to them
+func startChat(c chan string) {
+	if len(C.chatFile) == 0 {
+		return
+	}
+	l := log.New(os.Stdout, "chat	", myLogFormat)
+
+	routes, err := loadChatRoutes(C.chatFile)
+	if err != nil {
+		c <- fmt.Sprintf("%s", err)
+		return
+	}
+
+	setAlertChatRoutes(routes)
+	var batches []chan Datasource
+	for _, route := range routes {
+		l.Printf("Posting digests for %v to %v", route.Prefixes, redactURL(route.URL))
+		batch := make(chan Datasource, subscriberBuffer)
+		window, _ := route.window()
+		go batchDatasources(batch, window, func(route chatRoute) func([]Datasource) {
+			return func(dss []Datasource) {
+				body, _ := json.Marshal(chatDigest(route, dss))
+				postWithRetries(route.URL, body, route.Retries, len(dss))
+			}
+		}(route))
+		batches = append(batches, batch)
+	}
+
+	for ds := range subscribe() {
+		if i := routeFor(routes, ds.Name); i >= 0 {
+			batches[i] <- ds
+		}
+	}
+}
+
+// Renders a digest message for a batch of data sources
+func chatDigest(route chatRoute, dss []Datasource) chatMessage {
+	link := func(href string, text string) string {
+		if route.Format == "markdown" {
+			return fmt.Sprintf("[%s](%s)", text, href)
+		}
+		return fmt.Sprintf("<%s|%s>", href, text)
+	}
+
+	groups := groupByPrefix(dss, route.Depth)
+	lines := []string{fmt.Sprintf("*%d new metrics* in %d group(s):", len(dss), len(groups))}
+	for _, g := range groups {
+		examples := g.Names
+		if len(examples) > chatExamples {
+			examples = examples[:chatExamples]
+		}
+		line := fmt.Sprintf("• `%s` %d new (%s): %s", g.Prefix, len(g.Names),
+			link(groupGraphURL(g), "graph"), strings.Join(examples, ", "))
+		if len(g.Names) > chatExamples {
+			line += ", ..."
+		}
+		lines = append(lines, line)
+	}
+
+	return chatMessage{Text: strings.Join(lines, "\n"), Channel: route.Channel, Username: "graphite-news"}
+}
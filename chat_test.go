@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestChatDigest(t *testing.T) {
+	C.GraphiteURL = "http://graphite"
+	var dss []Datasource
+	for i := 0; i < 5; i++ {
+		dss = append(dss, Datasource{Name: fmt.Sprintf("app.checkout.api.latency-%v.p99", i)})
+	}
+	dss = append(dss, Datasource{Name: "app.search.count"})
+
+	groups := groupByPrefix(dss, 3)
+	if len(groups) != 2 || groups[0].Prefix != "app.checkout.api" || len(groups[0].Names) != 5 || groups[1].Prefix != "app.search.count" {
+		t.Fatal(fmt.Sprintf("Data sources were not grouped on prefix properly: %+v", groups))
+	}
+
+	msg := chatDigest(chatRoute{Channel: "#checkout", Depth: 3}, dss)
+	for _, expected := range []string{"*6 new metrics* in 2 group(s)", "`app.checkout.api` 5 new", "<http://graphite/?target=app.checkout.api.%2A.%2A|graph>", ", ...", "<http://graphite/?target=app.search.count|graph>"} {
+		if !strings.Contains(msg.Text, expected) {
+			t.Fatal(fmt.Sprintf("Digest does not contain [%v]: %v", expected, msg.Text))
+		}
+	}
+	if msg.Channel != "#checkout" {
+		t.Fatal(fmt.Sprintf("Digest was not sent to configured channel: %+v", msg))
+	}
+}
+
+func TestChatRouting(t *testing.T) {
+	routes := []chatRoute{
+		{Prefixes: []string{"app.payments", "app.checkout"}, URL: "payments"},
+		{Prefixes: []string{"app.*"}, URL: "app"},
+	}
+	var testCases = map[string]int{
+		"app.payments.latency": 0,
+		"app.checkout.count":   0,
+		"app.search.count":     1,
+		"collectd.cpu":         -1,
+	}
+	for name, expected := range testCases {
+		if i := routeFor(routes, name); i != expected {
+			t.Fatal(fmt.Sprintf("Expected %v to be routed to %v, got %v", name, expected, i))
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package main
+
+// Serves the points of a data source straight from its whisper file on
+// /data/{name}?from=&until=, in the JSON format of the graphite render API.
+// Lets the UI draw graphs on carbon hosts without graphite-web (-lg).
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A series as the render API returns it with format=json
+type dataSeries struct {
+	Target     string           `json:"target"`
+	Datapoints [][2]interface{} `json:"datapoints"` // [value or null, timestamp]
+}
+
+// Parses a from/until parameter: unix seconds, "now" or relative like -2h,
+// -30min or -7d. Empty gives def.
+func parseDataTime(s string, now time.Time, def time.Time) (time.Time, error) {
+	switch {
+	case len(s) == 0:
+		return def, nil
+	case s == "now":
+		return now, nil
+	case strings.HasPrefix(s, "-"):
+		s = strings.Replace(s, "min", "m", 1)
+		if strings.HasSuffix(s, "d") {
+			days, err := strconv.Atoi(s[1 : len(s)-1])
+			if err != nil {
+				return def, err
+			}
+			return now.AddDate(0, 0, -days), nil
+		}
+		d, err := time.ParseDuration(s)
+		return now.Add(d), err
+	}
+	secs, err := strconv.ParseInt(s, 10, 64)
+	return time.Unix(secs, 0), err
+}
+
+func dataHandler(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, "GET") {
+		return
+	}
+	name := strings.TrimPrefix(r.URL.Path, "/data/")
+	ds := getDSbyName(name)
+	if len(ds.Name) == 0 {
+		writeAPIError(w, http.StatusNotFound, "datasource_not_found", "No such data source: "+name)
+		return
+	}
+	if len(ds.filename) == 0 {
+		writeAPIError(w, http.StatusNotFound, "no_whisper_file", "No whisper file known for data source: "+name)
+		return
+	}
+
+	now := time.Now()
+	from, err := parseDataTime(r.URL.Query().Get("from"), now, now.Add(-24*time.Hour))
+	if err != nil {
+		writeAPIError(w, http.StatusBadRequest, "bad_from", "Invalid from: "+err.Error())
+		return
+	}
+	until, err := parseDataTime(r.URL.Query().Get("until"), now, now)
+	if err != nil {
+		writeAPIError(w, http.StatusBadRequest, "bad_until", "Invalid until: "+err.Error())
+		return
+	}
+
+	wsp, err := openWhisper(ds.filename)
+	if err != nil {
+		writeAPIError(w, http.StatusInternalServerError, "whisper_error", err.Error())
+		return
+	}
+	defer wsp.Close()
+	values, start, step, err := wsp.fetch(from.Unix(), until.Unix(), now.Unix())
+	if err != nil {
+		writeAPIError(w, http.StatusBadRequest, "bad_range", err.Error())
+		return
+	}
+
+	series := dataSeries{Target: ds.Name, Datapoints: [][2]interface{}{}}
+	for i, v := range values {
+		var value interface{}
+		if v != nil {
+			value = *v
+		}
+		series.Datapoints = append(series.Datapoints, [2]interface{}{value, start + int64(i)*step})
+	}
+	writeAPIJSON(w, http.StatusOK, []dataSeries{series})
+}
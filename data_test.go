@@ -0,0 +1,106 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestWhisperFetch(t *testing.T) {
+	now := int64(1410649860) // on a minute
+	filename := writeTestWhisper(t, [][2]uint32{{60, 60}, {3600, 24}}, []whisperPoint{
+		{uint32(now - 600), 1}, {uint32(now - 540), 2}, {uint32(now - 420), 4}, {uint32(now), 10},
+	})
+	defer os.Remove(filename)
+	wsp, err := openWhisper(filename)
+	if err != nil {
+		t.Fatal(fmt.Sprintf("Could not open whisper file: %v", err))
+	}
+	defer wsp.Close()
+
+	values, start, step, err := wsp.fetch(now-660, now-360, now)
+	if err != nil || start != now-600 || step != 60 || len(values) != 5 {
+		t.Fatal(fmt.Sprintf("Unexpected fetch: %v values from %v step %v (%v)", len(values), start, step, err))
+	}
+	expected := []interface{}{1.0, 2.0, nil, 4.0, nil}
+	for i, v := range values {
+		if (v == nil && expected[i] != nil) || (v != nil && *v != expected[i]) {
+			t.Fatal(fmt.Sprintf("Unexpected value %v at %v, expected %v", v, i, expected[i]))
+		}
+	}
+
+	// beyond the first archive, fetch from the second (with nothing in it)
+	values, _, step, _ = wsp.fetch(now-7200, now, now)
+	if step != 3600 || len(values) != 2 || values[0] != nil {
+		t.Fatal(fmt.Sprintf("Expected hourly values from second archive, got %v step %v", len(values), step))
+	}
+
+	if _, _, _, err := wsp.fetch(now, now-60, now); err == nil {
+		t.Fatal("Expected an error for from after until")
+	}
+}
+
+func TestParseDataTime(t *testing.T) {
+	now := time.Unix(1410649860, 0)
+	var testCases = map[string]int64{
+		"":           now.Unix() - 3600,
+		"now":        now.Unix(),
+		"1410000000": 1410000000,
+		"-2h":        now.Unix() - 7200,
+		"-30min":     now.Unix() - 1800,
+		"-7d":        now.AddDate(0, 0, -7).Unix(),
+	}
+	for s, expected := range testCases {
+		parsed, err := parseDataTime(s, now, now.Add(-time.Hour))
+		if err != nil || parsed.Unix() != expected {
+			t.Fatal(fmt.Sprintf("Expected %v to parse to %v, got %v (%v)", s, expected, parsed.Unix(), err))
+		}
+	}
+	if _, err := parseDataTime("yesterday", now, now); err == nil {
+		t.Fatal("Expected an error for an invalid time")
+	}
+}
+
+func TestDataHandler(t *testing.T) {
+	State.Vals = nil // start fresh
+	defer func() { State.Vals = nil }()
+	ts := uint32(time.Now().Unix()) / 60 * 60
+	filename := writeTestWhisper(t, [][2]uint32{{60, 1440}}, []whisperPoint{{ts - 60, 1.5}, {ts, 2.5}})
+	defer os.Remove(filename)
+	addItemToState(Datasource{Name: "local.random.diceroll", filename: filename})
+	addItemToState(Datasource{Name: "app.ingested.count"})
+
+	req, _ := http.NewRequest("GET", "/data/local.random.diceroll?from=-5min", nil)
+	w := httptest.NewRecorder()
+	dataHandler(w, req)
+	var series []dataSeries
+	if err := json.Unmarshal(w.Body.Bytes(), &series); err != nil || len(series) != 1 || series[0].Target != "local.random.diceroll" {
+		t.Fatal(fmt.Sprintf("Expected one series, got %v (%v)", w.Body.String(), err))
+	}
+	var written []interface{}
+	for _, p := range series[0].Datapoints {
+		if p[0] != nil {
+			written = append(written, p[0])
+		}
+	}
+	if fmt.Sprint(written) != "[1.5 2.5]" {
+		t.Fatal(fmt.Sprintf("Expected the 2 points written, got %v", w.Body.String()))
+	}
+
+	for url, status := range map[string]int{
+		"/data/app.ingested.count":                   http.StatusNotFound,
+		"/data/no.such.thing":                        http.StatusNotFound,
+		"/data/local.random.diceroll?from=yesterday": http.StatusBadRequest,
+	} {
+		req, _ = http.NewRequest("GET", url, nil)
+		w = httptest.NewRecorder()
+		dataHandler(w, req)
+		if w.Code != status {
+			t.Fatal(fmt.Sprintf("Expected %v for %v, got %v", status, url, w.Code))
+		}
+	}
+}
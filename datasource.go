@@ -0,0 +1,71 @@
+package main
+
+// Everything about a single data source on /datasource/{name}: what we
+// know from the logs, plus what its whisper file says right now. That tells
+// whether a new metric is actually receiving data.
+
+import (
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+type (
+	// Live information from a whisper file
+	whisperInfo struct {
+		Size       int64     // bytes on disk
+		Modified   time.Time // last write to the file
+		LastUpdate time.Time `json:",omitempty"` // most recent point written
+		Points     int       // points written so far in the first (most precise) archive
+		whisperHeader
+		Error string `json:",omitempty"` // why the rest is missing
+	}
+
+	datasourceInfo struct {
+		Datasource
+		Whisper *whisperInfo `json:",omitempty"` // not there for ingested or federated data sources
+	}
+)
+
+// Reads the live information of a whisper file
+func readWhisperInfo(filename string, now time.Time) *whisperInfo {
+	info := &whisperInfo{}
+	stat, err := os.Stat(filename)
+	if err != nil {
+		info.Error = err.Error()
+		return info
+	}
+	info.Size = stat.Size()
+	info.Modified = stat.ModTime()
+
+	w, err := openWhisper(filename)
+	if err != nil {
+		info.Error = err.Error()
+		return info
+	}
+	defer w.Close()
+	info.whisperHeader = w.Header
+	if info.Points, info.LastUpdate, err = w.writtenPoints(w.Header.Archives[0], now); err != nil {
+		info.Error = err.Error()
+	}
+	return info
+}
+
+func datasourceHandler(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, "GET") {
+		return
+	}
+	name := strings.TrimPrefix(r.URL.Path, "/datasource/")
+	ds := getDSbyName(name)
+	if len(ds.Name) == 0 {
+		writeAPIError(w, http.StatusNotFound, "datasource_not_found", "No such data source: "+name)
+		return
+	}
+
+	info := datasourceInfo{Datasource: ds}
+	if len(ds.filename) > 0 {
+		info.Whisper = readWhisperInfo(ds.filename, time.Now())
+	}
+	writeAPIJSON(w, http.StatusOK, info)
+}
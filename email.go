@@ -0,0 +1,344 @@
+package main
+
+// Mails a "what's new in Graphite" digest on a cron like schedule. Takes
+// the data sources created within the last Window from the create history
+// (see trends.go), groups them on prefix, and sends an HTML (with
+// sparklines from the render API) plus plain text version. Configured with
+// a JSON file (-em):
+//
+//	{"Server": "localhost:25", "From": "graphite-news@example.com", "To": ["team@example.com"],
+//	 "Schedule": "0 8 * * 1-5", "Window": "24h"}
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/rcrowley/go-metrics"
+	htmltemplate "html/template"
+	"io/ioutil"
+	"log"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+	"time"
+)
+
+type (
+	// Configuration of the email digest
+	emailConfig struct {
+		Server   string // host:port of the SMTP server
+		Username string // if set, authenticate with PLAIN auth
+		Password string
+		From     string
+		To       []string
+		Subject  string // defaults to "What's new in Graphite"
+		Schedule string // cron style: minute hour day-of-month month day-of-week
+		Window   string // mail data sources created this long ago, defaults to 24h
+		Depth    int    // number of name segments to group on, defaults to 3
+
+		schedule cronSchedule
+		window   time.Duration
+	}
+
+	// Allowed values for each of the 5 cron fields, bit i set means value i
+	// is allowed. Day of month/week follow cron: if both are restricted
+	// either one has to match.
+	cronSchedule struct {
+		fields           [5]uint64
+		domStar, dowStar bool
+	}
+
+	// Everything the digest templates get to see
+	emailDigest struct {
+		Since  time.Time
+		Count  int
+		Groups []emailGroup
+	}
+
+	emailGroup struct {
+		Prefix   string
+		GraphURL string
+		Items    []emailItem
+		More     int // number of data sources not listed
+	}
+
+	emailItem struct {
+		Name        string
+		SparkURL    string
+		GraphURL    string
+		Create_date time.Time
+	}
+)
+
+const (
+	defaultEmailWindow = 24 * time.Hour
+
+	// Number of data sources listed per group, the rest is only counted
+	emailItemsPerGroup = 10
+)
+
+// Lower and upper bounds for minute, hour, day of month, month, day of week
+var cronBounds = [5][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 6}}
+
+var emailText = texttemplate.Must(texttemplate.New("text").Parse(
+	`{{.Count}} new data sources in Graphite since {{.Since.Format "Mon Jan 2 15:04"}}:
+{{range .Groups}}
+{{.Prefix}} ({{len .Items}}{{if .More}}+{{.More}}{{end}} new) {{.GraphURL}}
+{{range .Items}}  - {{.Name}}
+{{end}}{{if .More}}  ... and {{.More}} more
+{{end}}{{end}}`))
+
+var emailHTML = htmltemplate.Must(htmltemplate.New("html").Parse(
+	`<html><body style="font-family: sans-serif">
+<h2>{{.Count}} new data sources in Graphite</h2>
+<p>Since {{.Since.Format "Mon Jan 2 15:04"}}</p>
+{{range .Groups}}<h3><a href="{{.GraphURL}}">{{.Prefix}}</a> ({{len .Items}}{{if .More}}+{{.More}}{{end}} new)</h3>
+<table>
+{{range .Items}}<tr><td><a href="{{.GraphURL}}">{{.Name}}</a></td><td><img src="{{.SparkURL}}" alt="" width="120" height="24"></td></tr>
+{{end}}{{if .More}}<tr><td colspan="2">... and {{.More}} more</td></tr>
+{{end}}</table>
+{{end}}</body></html>
+`))
+
+func loadEmailConfig(file string) (emailConfig, error) {
+	var cfg emailConfig
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return cfg, err
+	}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("Could not parse %v: %v", file, err)
+	}
+	if len(cfg.Server) == 0 || len(cfg.From) == 0 || len(cfg.To) == 0 {
+		return cfg, fmt.Errorf("Email digest needs a Server, From and To in %v", file)
+	}
+	if cfg.schedule, err = parseCron(cfg.Schedule); err != nil {
+		return cfg, fmt.Errorf("Invalid Schedule in %v: %v", file, err)
+	}
+	cfg.window = defaultEmailWindow
+	if len(cfg.Window) > 0 {
+		if cfg.window, err = time.ParseDuration(cfg.Window); err != nil {
+			return cfg, fmt.Errorf("Invalid Window in %v: %v", file, err)
+		}
+	}
+	if len(cfg.Subject) == 0 {
+		cfg.Subject = "What's new in Graphite"
+	}
+	if cfg.Depth < 1 {
+		cfg.Depth = defaultChatDepth
+	}
+	return cfg, nil
+}
+
+// Parses a cron style schedule like "0 8 * * 1-5". Fields can be *, a
+// number, a range (1-5), a step (*/15, 0-30/10) or a list of those (1,3,5)
+func parseCron(spec string) (cronSchedule, error) {
+	var s cronSchedule
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return s, fmt.Errorf("expected 5 fields, got %v: '%v'", len(fields), spec)
+	}
+
+	for i, field := range fields {
+		for _, part := range strings.Split(field, ",") {
+			lo, hi, step := cronBounds[i][0], cronBounds[i][1], 1
+			rng := part
+			if j := strings.Index(part, "/"); j >= 0 {
+				var err error
+				if step, err = strconv.Atoi(part[j+1:]); err != nil || step < 1 {
+					return s, fmt.Errorf("invalid step in '%v'", part)
+				}
+				rng = part[:j]
+			}
+			if rng != "*" {
+				bounds := strings.SplitN(rng, "-", 2)
+				var err1, err2 error
+				lo, err1 = strconv.Atoi(bounds[0])
+				hi, err2 = lo, nil
+				if len(bounds) == 2 {
+					hi, err2 = strconv.Atoi(bounds[1])
+				} else if step > 1 {
+					hi = cronBounds[i][1]
+				}
+				if err1 != nil || err2 != nil || lo < cronBounds[i][0] || hi > cronBounds[i][1] || lo > hi {
+					return s, fmt.Errorf("invalid value '%v'", part)
+				}
+			}
+			for v := lo; v <= hi; v += step {
+				s.fields[i] |= 1 << uint(v)
+			}
+		}
+	}
+	s.domStar = fields[2] == "*"
+	s.dowStar = fields[4] == "*"
+	return s, nil
+}
+
+func (s cronSchedule) matches(t time.Time) bool {
+	has := func(i int, v int) bool { return s.fields[i]&(1<<uint(v)) != 0 }
+	if !has(0, t.Minute()) || !has(1, t.Hour()) || !has(3, int(t.Month())) {
+		return false
+	}
+	dom, dow := has(2, t.Day()), has(4, int(t.Weekday()))
+	if s.domStar || s.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// Returns the first minute after t that matches the schedule, or the zero
+// time if there is none within the next 5 years (f.ex. on February 30th)
+func (s cronSchedule) next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	for end := t.AddDate(5, 0, 0); t.Before(end); t = t.Add(time.Minute) {
+		if s.matches(t) {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// Loads the email configuration and sends a digest on every scheduled time
+func startEmail(c chan string) {
+	if len(C.emailFile) == 0 {
+		return
+	}
+	l := log.New(os.Stdout, "email	", myLogFormat)
+
+	cfg, err := loadEmailConfig(C.emailFile)
+	if err != nil {
+		c <- fmt.Sprintf("%s", err)
+		return
+	}
+
+	for {
+		next := cfg.schedule.next(time.Now())
+		if next.IsZero() {
+			c <- fmt.Sprintf("Email schedule '%v' never fires", cfg.Schedule)
+			return
+		}
+		l.Printf("Next email digest to %v at %v", cfg.To, next)
+		time.Sleep(next.Sub(time.Now()))
+
+		if err := sendDigest(cfg, next); err != nil {
+			l.Printf("Sending email digest failed: %v", err)
+		}
+	}
+}
+
+// Collects the data sources created in the window before now, and mails
+// them. These come from the create history, the State only holds the last
+// 100. Nothing gets sent if there is no news.
+func sendDigest(cfg emailConfig, now time.Time) error {
+	l := log.New(os.Stdout, "email	", myLogFormat)
+	m := metrics.GetOrRegisterCounter("email.sent", metrics.DefaultRegistry)
+
+	since := now.Add(-cfg.window)
+	var dss []Datasource
+	seen := map[string]bool{}
+	for _, e := range history.since(since) {
+		if !e.Create_date.After(now) && !seen[e.Name] {
+			seen[e.Name] = true
+			dss = append(dss, e.datasource())
+		}
+	}
+	if len(dss) == 0 {
+		l.Printf("No new data sources since %v, not sending a digest", since)
+		return nil
+	}
+
+	msg, err := renderDigest(cfg, buildDigest(cfg, dss, since), now)
+	if err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if len(cfg.Username) > 0 {
+		host, _, _ := net.SplitHostPort(cfg.Server)
+		auth = smtp.PlainAuth("", cfg.Username, cfg.Password, host)
+	}
+	if err := smtp.SendMail(cfg.Server, auth, cfg.From, cfg.To, msg); err != nil {
+		return err
+	}
+	l.Printf("Sent email digest of %v data sources to %v", len(dss), cfg.To)
+	m.Inc(1)
+	return nil
+}
+
+func buildDigest(cfg emailConfig, dss []Datasource, since time.Time) emailDigest {
+	digest := emailDigest{Since: since, Count: len(dss)}
+	byName := map[string]Datasource{}
+	for _, ds := range dss {
+		byName[ds.Name] = ds
+	}
+
+	for _, g := range groupByPrefix(dss, cfg.Depth) {
+		group := emailGroup{Prefix: g.Prefix, GraphURL: groupGraphURL(g)}
+		for i, name := range g.Names {
+			if i == emailItemsPerGroup {
+				group.More = len(g.Names) - i
+				break
+			}
+			ds := byName[name]
+			group.Items = append(group.Items, emailItem{
+				Name:        name,
+				SparkURL:    sparklineURL(ds, cfg.window),
+				GraphURL:    dsGraphURL(ds),
+				Create_date: ds.Create_date,
+			})
+		}
+		digest.Groups = append(digest.Groups, group)
+	}
+	return digest
+}
+
+// URL of a small graph, without any axes or legend, from the render API
+func sparklineURL(ds Datasource, period time.Duration) string {
+	v := url.Values{}
+	v.Set("target", ds.Name)
+	v.Set("from", fmt.Sprintf("-%vmin", int(period.Minutes())))
+	v.Set("width", "120")
+	v.Set("height", "24")
+	v.Set("graphOnly", "true")
+	v.Set("lineWidth", "1")
+	return graphiteURLFor(ds) + "/render/?" + v.Encode()
+}
+
+// Builds the full email, headers and both a text and HTML part
+func renderDigest(cfg emailConfig, digest emailDigest, now time.Time) ([]byte, error) {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+
+	text, err := mw.CreatePart(textproto.MIMEHeader{"Content-Type": {"text/plain; charset=utf-8"}})
+	if err == nil {
+		err = emailText.Execute(text, digest)
+	}
+	if err != nil {
+		return nil, err
+	}
+	html, err := mw.CreatePart(textproto.MIMEHeader{"Content-Type": {"text/html; charset=utf-8"}})
+	if err == nil {
+		err = emailHTML.Execute(html, digest)
+	}
+	if err != nil {
+		return nil, err
+	}
+	mw.Close()
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", cfg.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(cfg.To, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", cfg.Subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	fmt.Fprintf(&msg, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&msg, "Content-Type: multipart/alternative; boundary=%s\r\n\r\n", mw.Boundary())
+	body.WriteTo(&msg)
+	return msg.Bytes(), nil
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"fmt"
+	"mime"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestParseCron(t *testing.T) {
+	type testpair struct {
+		spec string
+		from string
+		next string
+	}
+
+	var testCases = []testpair{
+		{"0 8 * * *", "2014-09-13 07:59", "2014-09-13 08:00"},
+		{"0 8 * * *", "2014-09-13 08:00", "2014-09-14 08:00"},
+		{"*/15 * * * *", "2014-09-13 08:01", "2014-09-13 08:15"},
+		{"30 9 * * 1-5", "2014-09-13 10:00", "2014-09-15 09:30"}, // saturday -> monday
+		{"0 0 1,15 * *", "2014-09-02 00:00", "2014-09-15 00:00"},
+		{"0 0 13 * 5", "2014-09-01 00:00", "2014-09-05 00:00"}, // friday, or the 13th
+		{"0 0 30 2 *", "2014-09-01 00:00", ""},
+	}
+	for _, test := range testCases {
+		s, err := parseCron(test.spec)
+		if err != nil {
+			t.Fatal(fmt.Sprintf("Could not parse schedule [%v]: %v", test.spec, err))
+		}
+		from, _ := time.Parse("2006-01-02 15:04", test.from)
+		next := s.next(from)
+		if (len(test.next) == 0 && !next.IsZero()) || (len(test.next) > 0 && next.Format("2006-01-02 15:04") != test.next) {
+			t.Fatal(fmt.Sprintf("Schedule [%v] from %v should fire at [%v], got %v", test.spec, test.from, test.next, next))
+		}
+	}
+
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "a * * * *", "*/0 * * * *", "5-1 * * * *"} {
+		if _, err := parseCron(spec); err == nil {
+			t.Fatal(fmt.Sprintf("Invalid schedule [%v] was accepted", spec))
+		}
+	}
+}
+
+// Minimal SMTP server that accepts a single mail and hands over its content
+func smtpSink(ln net.Listener, mail chan string) {
+	conn, err := ln.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+	tp.PrintfLine("220 localhost sink")
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		switch cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0]); cmd {
+		case "EHLO", "HELO":
+			tp.PrintfLine("250 localhost")
+		case "DATA":
+			tp.PrintfLine("354 go ahead")
+			data, _ := tp.ReadDotBytes()
+			mail <- string(data)
+			tp.PrintfLine("250 ok")
+		case "QUIT":
+			tp.PrintfLine("221 bye")
+			return
+		default:
+			tp.PrintfLine("250 ok")
+		}
+	}
+}
+
+func TestDigestSubject(t *testing.T) {
+	cfg := emailConfig{From: "news@example.com", To: []string{"team@example.com"}, Subject: "Nieuw in Graphite: café\r\nBcc: evil@example.com"}
+	msg, err := renderDigest(cfg, emailDigest{}, time.Now())
+	if err != nil {
+		t.Fatal(fmt.Sprintf("Rendering digest failed: %v", err))
+	}
+	if strings.Contains(string(msg), "\r\nBcc:") {
+		t.Fatal(fmt.Sprintf("Subject could add headers to the mail: %q", msg))
+	}
+	var subject string
+	for _, line := range strings.Split(string(msg), "\r\n") {
+		if strings.HasPrefix(line, "Subject: ") {
+			subject = strings.TrimPrefix(line, "Subject: ")
+		}
+	}
+	if decoded, err := new(mime.WordDecoder).DecodeHeader(subject); err != nil || decoded != cfg.Subject {
+		t.Fatal(fmt.Sprintf("Expected the subject to decode to %q, got %q (%v)", cfg.Subject, decoded, err))
+	}
+}
+
+func TestSendDigest(t *testing.T) {
+	saved := history
+	history = &createHistory{&sync.RWMutex{}, nil}
+	defer func() { history = saved }()
+
+	ln, _ := net.Listen("tcp", "127.0.0.1:0")
+	defer ln.Close()
+	mail := make(chan string, 1)
+	go smtpSink(ln, mail)
+
+	now := time.Now()
+	history.add(Datasource{Name: "app.checkout.api.latency", Create_date: now.Add(-time.Hour)})
+	history.add(Datasource{Name: "app.checkout.api.<count>", Create_date: now.Add(-2 * time.Hour)})
+	history.add(Datasource{Name: "app.old.metric", Create_date: now.Add(-48 * time.Hour)})
+	// more than the State holds on a busy day
+	for i := 0; i < 2*maxState; i++ {
+		history.add(Datasource{Name: fmt.Sprintf("app.busy.nodes.n%v", i), Create_date: now.Add(-3 * time.Hour)})
+	}
+
+	cfg := emailConfig{Server: ln.Addr().String(), From: "news@example.com", To: []string{"team@example.com"},
+		Subject: "News", Depth: 3, window: 24 * time.Hour}
+	if err := sendDigest(cfg, now); err != nil {
+		t.Fatal(fmt.Sprintf("Sending digest failed: %v", err))
+	}
+
+	var msg string
+	select {
+	case msg = <-mail:
+	case <-time.After(5 * time.Second):
+		t.Fatal("SMTP sink did not receive a mail")
+	}
+	for _, expected := range []string{"Subject: News", "multipart/alternative", "text/plain", "text/html",
+		fmt.Sprintf("%v new data sources", 2+2*maxState), "app.checkout.api (2 new)", fmt.Sprintf("app.busy.nodes (%v+%v new)", emailItemsPerGroup, 2*maxState-emailItemsPerGroup), "app.checkout.api.&lt;count&gt;", "/render/?"} {
+		if !strings.Contains(msg, expected) {
+			t.Fatal(fmt.Sprintf("Mail does not contain [%v]: %v", expected, msg))
+		}
+	}
+	if strings.Contains(msg, "app.old.metric") {
+		t.Fatal("Mail contains a data source from outside the window")
+	}
+}
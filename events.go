@@ -0,0 +1,91 @@
+package main
+
+// Records new data sources as events in Graphite (-ev), so dashboards can
+// show "new metrics appeared" with drawAsInfinite(events("new-metrics")).
+// Data sources are collected for a window (-ew) and then posted to the
+// events API of their backend, one event per prefix, with the prefix as
+// tags. Failed posts are retried like webhooks, and show up in /webhooks/.
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+)
+
+// An event as the Graphite events API takes it
+type graphiteEvent struct {
+	What string `json:"what"`
+	Tags string `json:"tags"` // space separated, older graphite-webs only take a string
+	When int64  `json:"when"`
+	Data string `json:"data"`
+}
+
+const (
+	// Number of name segments to group data sources on
+	eventPrefixDepth = 2
+
+	// Attempts to post an event after the first one failed
+	eventRetries = 3
+
+	// Number of names listed in an event
+	eventMaxNames = 50
+)
+
+// Turns a batch of data sources into events per backend and prefix
+func buildEvents(dss []Datasource) map[string][]graphiteEvent {
+	byBackend := map[string][]Datasource{}
+	for _, ds := range dss {
+		byBackend[ds.Backend] = append(byBackend[ds.Backend], ds)
+	}
+
+	events := map[string][]graphiteEvent{}
+	for backend, list := range byBackend {
+		var when int64
+		for _, ds := range list {
+			if t := ds.Create_date.Unix(); t > when {
+				when = t
+			}
+		}
+		for _, g := range groupByPrefix(list, eventPrefixDepth) {
+			tags := []string{"graphite-news", "new-metrics"}
+			parts := strings.Split(g.Prefix, ".")
+			for i := range parts {
+				tags = append(tags, strings.Join(parts[:i+1], "."))
+			}
+			names := g.Names
+			if len(names) > eventMaxNames {
+				names = append(names[:eventMaxNames:eventMaxNames], fmt.Sprintf("... and %v more", len(g.Names)-eventMaxNames))
+			}
+			events[backend] = append(events[backend], graphiteEvent{
+				What: fmt.Sprintf("%v new metrics under %v", len(g.Names), g.Prefix),
+				Tags: strings.Join(tags, " "),
+				When: when,
+				Data: strings.Join(names, "\n"),
+			})
+		}
+	}
+	return events
+}
+
+// Posts the events to the events API of their backends
+func postEvents(dss []Datasource) {
+	for backend, events := range buildEvents(dss) {
+		url := graphiteURLFor(Datasource{Backend: backend}) + "/events/"
+		for _, e := range events {
+			body, _ := json.Marshal(e)
+			postWithRetries(url, body, eventRetries, strings.Count(e.Data, "\n")+1)
+		}
+	}
+}
+
+// Batches new data sources and records them as events
+func recordEvents() {
+	if !C.graphiteEvents {
+		return
+	}
+	l := log.New(os.Stdout, "events	", myLogFormat)
+	l.Printf("Recording new data sources as Graphite events every %v", C.eventWindow)
+	batchDatasources(subscribe(), C.eventWindow, postEvents)
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBuildEvents(t *testing.T) {
+	now := time.Now()
+	var dss []Datasource
+	for i := 0; i < 60; i++ {
+		dss = append(dss, Datasource{Name: fmt.Sprintf("app.payments.node%v.count", i), Create_date: now})
+	}
+	dss = append(dss, Datasource{Name: "app.checkout.count", Create_date: now.Add(-time.Minute)})
+	dss = append(dss, Datasource{Name: "eu.app.count", Create_date: now.Add(-time.Minute), Backend: "eu"})
+
+	events := buildEvents(dss)
+	if len(events) != 2 || len(events[""]) != 2 || len(events["eu"]) != 1 {
+		t.Fatal(fmt.Sprintf("Expected events per backend and prefix, got %+v", events))
+	}
+	e := events[""][0]
+	if e.What != "60 new metrics under app.payments" || e.Tags != "graphite-news new-metrics app app.payments" || e.When != now.Unix() {
+		t.Fatal(fmt.Sprintf("Unexpected event: %+v", e))
+	}
+	if strings.Count(e.Data, "\n") != eventMaxNames || !strings.HasSuffix(e.Data, "\n... and 10 more") {
+		t.Fatal(fmt.Sprintf("Expected names to be capped, got %v", e.Data))
+	}
+}
+
+func TestPostEvents(t *testing.T) {
+	var lock sync.Mutex
+	var received []graphiteEvent
+	fails := 1
+	graphite := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		if r.URL.Path != "/events/" || r.Method != "POST" {
+			t.Error(fmt.Sprintf("Unexpected request %v %v", r.Method, r.URL))
+		}
+		if fails > 0 {
+			fails--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var e graphiteEvent
+		body, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(body, &e)
+		received = append(received, e)
+	}))
+	defer graphite.Close()
+	defer func(url string) { C.GraphiteURL = url }(C.GraphiteURL)
+	C.GraphiteURL = graphite.URL
+
+	postEvents([]Datasource{{Name: "local.random.diceroll", Create_date: time.Now()}})
+	lock.Lock()
+	defer lock.Unlock()
+	if len(received) != 1 || received[0].What != "1 new metrics under local.random" || received[0].Data != "local.random.diceroll" {
+		t.Fatal(fmt.Sprintf("Expected event to be posted after a retry, got %+v", received))
+	}
+}
@@ -0,0 +1,118 @@
+package main
+
+// Exports the data sources as CSV or newline delimited JSON, for scripts
+// that want a dump of creates. Asked for with /json/?format=csv or ndjson,
+// or an Accept header of text/csv or application/x-ndjson. Rows are written
+// (and flushed) one at a time instead of marshalling everything in one go.
+// With ?history=1 the whole create history is exported instead of only
+// the data sources in the State.
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Flush the response every this many rows
+const exportFlushRows = 1000
+
+var (
+	exportHeader = []string{"name", "create_date", "retention", "logfile", "instance", "host", "upstream"}
+
+	// (archive=[(60, 525600), (600, 518400)] xff=None agg=None)
+	archiveRe = regexp.MustCompile(`archive=\[(.*?)\]`)
+	pointsRe  = regexp.MustCompile(`\(\s*(\d+)\s*,\s*(\d+)\s*\)`)
+)
+
+// Returns the requested export format: csv, ndjson or "" for plain JSON
+func exportFormat(r *http.Request) string {
+	if format := r.URL.Query().Get("format"); format == "json" {
+		return ""
+	} else if len(format) > 0 {
+		return format
+	}
+	accept := r.Header.Get("Accept")
+	switch {
+	case strings.Contains(accept, "text/csv"):
+		return "csv"
+	case strings.Contains(accept, "application/x-ndjson"):
+		return "ndjson"
+	}
+	return ""
+}
+
+// Retention of a data source as seconds per point:points, f.ex.
+// 60:525600,600:518400, taken from the archives in its Params
+func retention(params string) string {
+	match := archiveRe.FindStringSubmatch(params)
+	if match == nil {
+		return ""
+	}
+	var archives []string
+	for _, p := range pointsRe.FindAllStringSubmatch(match[1], -1) {
+		archives = append(archives, p[1]+":"+p[2])
+	}
+	return strings.Join(archives, ",")
+}
+
+// The data sources to export, from the history if asked for
+func exportDatasources(r *http.Request) []Datasource {
+	f := newFilter(r)
+	if len(r.URL.Query().Get("history")) == 0 {
+		return getFilteredDSs(f)
+	}
+	var dss []Datasource
+	for _, e := range history.since(time.Time{}) {
+		ds := e.datasource()
+		if f.match(ds) {
+			dss = append(dss, ds)
+		}
+	}
+	return dss
+}
+
+// Writes the data sources in the given format, returns false if the format
+// is not known
+func writeExport(w http.ResponseWriter, r *http.Request, format string) bool {
+	var write func(ds Datasource) error
+	var done func()
+	switch format {
+	case "csv":
+		cw := csv.NewWriter(w)
+		write = func(ds Datasource) error {
+			return cw.Write([]string{ds.Name, ds.Create_date.Format(time.RFC3339), retention(ds.Params), ds.Logfile, ds.Instance, ds.Host, ds.Upstream})
+		}
+		done = cw.Flush
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", "attachment; filename=datasources.csv")
+		cw.Write(exportHeader)
+	case "ndjson":
+		enc := json.NewEncoder(w)
+		write = func(ds Datasource) error {
+			return enc.Encode(struct {
+				Datasource
+				Retention string
+			}{ds, retention(ds.Params)})
+		}
+		done = func() {}
+		w.Header().Set("Content-Type", "application/x-ndjson")
+	default:
+		return false
+	}
+
+	flusher, _ := w.(http.Flusher)
+	for i, ds := range exportDatasources(r) {
+		if err := write(ds); err != nil {
+			return true // client went away
+		}
+		if flusher != nil && (i+1)%exportFlushRows == 0 {
+			done()
+			flusher.Flush()
+		}
+	}
+	done()
+	return true
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRetention(t *testing.T) {
+	var testCases = map[string]string{
+		"(archive=[(60, 525600), (600, 518400)] xff=None agg=None)": "60:525600,600:518400",
+		"(archive=[(10, 8640)] xff=0.5 agg=sum)":                    "10:8640",
+		"":                                                          "",
+	}
+	for params, expected := range testCases {
+		if r := retention(params); r != expected {
+			t.Fatal(fmt.Sprintf("Expected retention [%v] for %v, got [%v]", expected, params, r))
+		}
+	}
+}
+
+func TestExport(t *testing.T) {
+	State.Vals = nil // start fresh
+	defer func() { State.Vals = nil }()
+	params := "(archive=[(60, 525600), (600, 518400)] xff=None agg=None)"
+	addItemToState(Datasource{Name: "app.payments.count", Create_date: time.Now(), Params: params, Origin: Origin{Host: "carbon1", Instance: "carbon-cache-a"}})
+	addItemToState(Datasource{Name: "local.random.diceroll", Create_date: time.Now()})
+
+	req, _ := http.NewRequest("GET", "/json/?format=csv", nil)
+	w := httptest.NewRecorder()
+	jsonHandler(w, req)
+	rows, err := csv.NewReader(w.Body).ReadAll()
+	if err != nil || len(rows) != 3 || strings.Join(rows[0], ",") != strings.Join(exportHeader, ",") {
+		t.Fatal(fmt.Sprintf("Expected a header and 2 rows, got %v (%v)", rows, err))
+	}
+	if rows[1][0] != "app.payments.count" || rows[1][2] != "60:525600,600:518400" || rows[1][5] != "carbon1" {
+		t.Fatal(fmt.Sprintf("Unexpected CSV row: %v", rows[1]))
+	}
+
+	req, _ = http.NewRequest("GET", "/json/?host=carbon1", nil)
+	req.Header.Set("Accept", "application/x-ndjson")
+	w = httptest.NewRecorder()
+	jsonHandler(w, req)
+	var lines []map[string]interface{}
+	scanner := bufio.NewScanner(w.Body)
+	for scanner.Scan() {
+		var line map[string]interface{}
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			t.Fatal(fmt.Sprintf("Invalid NDJSON line %v: %v", scanner.Text(), err))
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) != 1 || lines[0]["Name"] != "app.payments.count" || lines[0]["Retention"] != "60:525600,600:518400" {
+		t.Fatal(fmt.Sprintf("Expected one filtered NDJSON line, got %v", lines))
+	}
+
+	req, _ = http.NewRequest("GET", "/json/?format=xml", nil)
+	w = httptest.NewRecorder()
+	jsonHandler(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Fatal(fmt.Sprintf("Expected unknown format to be refused, got %v", w.Code))
+	}
+}
+
+func TestExportHistory(t *testing.T) {
+	saved := history
+	history = &createHistory{&sync.RWMutex{}, nil}
+	defer func() { history = saved }()
+	for i := 0; i < 3; i++ {
+		history.add(Datasource{Name: fmt.Sprintf("app.node%v.count", i), Create_date: time.Now()})
+	}
+
+	req, _ := http.NewRequest("GET", "/json/?format=csv&history=1&prefix=app.node[01]", nil)
+	w := httptest.NewRecorder()
+	jsonHandler(w, req)
+	rows, _ := csv.NewReader(w.Body).ReadAll()
+	if len(rows) != 3 || rows[2][0] != "app.node1.count" {
+		t.Fatal(fmt.Sprintf("Expected 2 data sources from the history, got %v", rows))
+	}
+}
@@ -0,0 +1,106 @@
+package main
+
+// Federation: a central graphite-news that polls the /json/ feeds of other
+// graphite-news instances (f.ex. one per carbon host) and merges them into
+// its own State. Every data source remembers which upstream it came from,
+// so deletes can be forwarded to the instance that owns the whisper file.
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/rcrowley/go-metrics"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// Don't wait forever on upstreams that are down
+	upstreamClient = &http.Client{Timeout: 10 * time.Second}
+
+	// Names each upstream returned on its last poll. Our State only holds
+	// maxState data sources, so with several upstreams (or tailing too) the
+	// ones from the last poll may be gone from it already. An upstream only
+	// ever returns its newest data sources, so anything it returned last time
+	// is not new.
+	upstreamSeen = struct {
+		*sync.Mutex
+		names map[string]map[string]bool
+	}{&sync.Mutex{}, map[string]map[string]bool{}}
+)
+
+// Starts polling every configured upstream, at the same interval as
+// browsers poll us.
+func pollUpstreams() {
+	for _, upstream := range C.upstreams {
+		go func(upstream string) {
+			for {
+				pollUpstream(upstream)
+				time.Sleep(time.Duration(C.JsonPullInterval) * time.Millisecond)
+			}
+		}(strings.TrimRight(upstream, "/"))
+	}
+}
+
+// Fetches all data sources of an upstream and adds them to our State,
+// tagged with the upstream they came from.
+func pollUpstream(upstream string) error {
+	l := log.New(os.Stdout, "federate	", myLogFormat)
+	m := metrics.GetOrRegisterCounter("federation.errors", metrics.DefaultRegistry)
+
+	resp, err := upstreamClient.Get(upstream + "/json/")
+	if err == nil {
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			err = fmt.Errorf("unexpected status %v", resp.Status)
+		}
+	}
+
+	var dss []Datasource
+	if err == nil {
+		err = json.NewDecoder(resp.Body).Decode(&dss)
+	}
+	if err != nil {
+		l.Printf("Could not poll upstream %v: %v", upstream, err)
+		m.Inc(1)
+		return err
+	}
+
+	upstreamSeen.Lock()
+	seen := upstreamSeen.names[upstream]
+	names := map[string]bool{}
+	for _, ds := range dss {
+		names[ds.Name] = true
+	}
+	upstreamSeen.names[upstream] = names
+	upstreamSeen.Unlock()
+
+	for _, ds := range dss {
+		if seen[ds.Name] {
+			continue
+		}
+		ds.Upstream = upstream
+		addItemToState(ds)
+	}
+	return nil
+}
+
+// Asks the upstream that owns a data source to delete it
+func forwardDelete(ds Datasource) bool {
+	l := log.New(os.Stdout, "federate	", myLogFormat)
+
+	resp, err := upstreamClient.PostForm(ds.Upstream+"/delete/",
+		url.Values{"datasourcename": {ds.Name}})
+	if err != nil {
+		l.Printf("Forwarding delete of %v to %v failed: %v", ds.Name, ds.Upstream, err)
+		return false
+	}
+	resp.Body.Close()
+
+	l.Printf("Forwarded delete of %v to %v: %v", ds.Name, ds.Upstream, resp.Status)
+	return resp.StatusCode == http.StatusOK
+}
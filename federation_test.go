@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"github.com/rcrowley/go-metrics"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestFederation(t *testing.T) {
+	State.Vals = nil // start fresh
+	defer func() { State.Vals = nil }()
+
+	deleted := ""
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/json/":
+			fmt.Fprint(w, `[{"Name": "upstream.a", "Host": "carbon1"}, {"Name": "upstream.b", "Host": "carbon1"}]`)
+		case "/delete/":
+			deleted = r.PostFormValue("datasourcename")
+		}
+	}))
+	defer upstream.Close()
+
+	if err := pollUpstream(upstream.URL); err != nil {
+		t.Fatal(fmt.Sprintf("Polling upstream failed: %v", err))
+	}
+	// polling twice should not result in duplicates
+	pollUpstream(upstream.URL)
+	if len(State.Vals) != 2 {
+		t.Fatal(fmt.Sprintf("Expected 2 data sources from upstream, got %+v", State.Vals))
+	}
+	if ds := getDSbyName("upstream.a"); ds.Upstream != upstream.URL || ds.Host != "carbon1" {
+		t.Fatal(fmt.Sprintf("Data source was not tagged with its upstream: %+v", ds))
+	}
+
+	if !forwardDelete(getDSbyName("upstream.b")) || deleted != "upstream.b" {
+		t.Fatal(fmt.Sprintf("Delete was not forwarded to upstream, it got [%v]", deleted))
+	}
+
+	if err := pollUpstream("http://127.0.0.1:1"); err == nil {
+		t.Fatal("Polling an unreachable upstream did not return an error")
+	}
+}
+
+func TestFederationManyUpstreams(t *testing.T) {
+	State.Vals = nil // start fresh
+	defer func() { State.Vals = nil }()
+
+	// two upstreams with a full State each push each other out of ours
+	var upstreams []string
+	for _, name := range []string{"a", "b"} {
+		var dss []string
+		for i := 0; i < maxState; i++ {
+			dss = append(dss, fmt.Sprintf(`{"Name": "upstream.%v.%v"}`, name, i))
+		}
+		body := "[" + strings.Join(dss, ",") + "]"
+		upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, body)
+		}))
+		defer upstream.Close()
+		upstreams = append(upstreams, upstream.URL)
+	}
+
+	m := metrics.GetOrRegisterCounter("tail.datasources", metrics.DefaultRegistry)
+	before := m.Count()
+	for i := 0; i < 2; i++ {
+		for _, upstream := range upstreams {
+			pollUpstream(upstream)
+		}
+	}
+	if added := m.Count() - before; added != int64(2*maxState) {
+		t.Fatal(fmt.Sprintf("Expected every data source to be added once, got %v adds", added))
+	}
+}
@@ -0,0 +1,174 @@
+package main
+
+// The news as a feed, in Atom (/feed.atom) and RSS (/feed.rss) flavour.
+// Takes the same filters as /json/, so /feed.atom?prefix=app.payments is a
+// feed of new metrics under app.payments. Every entry links to its graph.
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+type (
+	atomFeed struct {
+		XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+		Title   string      `xml:"title"`
+		ID      string      `xml:"id"`
+		Updated string      `xml:"updated"`
+		Links   []atomLink  `xml:"link"`
+		Author  atomAuthor  `xml:"author"`
+		Entries []atomEntry `xml:"entry"`
+	}
+
+	atomLink struct {
+		Href string `xml:"href,attr"`
+		Rel  string `xml:"rel,attr,omitempty"`
+	}
+
+	atomAuthor struct {
+		Name string `xml:"name"`
+	}
+
+	atomEntry struct {
+		Title   string   `xml:"title"`
+		ID      string   `xml:"id"`
+		Updated string   `xml:"updated"`
+		Link    atomLink `xml:"link"`
+		Summary string   `xml:"summary"`
+	}
+
+	rssFeed struct {
+		XMLName xml.Name   `xml:"rss"`
+		Version string     `xml:"version,attr"`
+		Channel rssChannel `xml:"channel"`
+	}
+
+	rssChannel struct {
+		Title       string    `xml:"title"`
+		Link        string    `xml:"link"`
+		Description string    `xml:"description"`
+		LastBuild   string    `xml:"lastBuildDate,omitempty"`
+		Items       []rssItem `xml:"item"`
+	}
+
+	rssItem struct {
+		Title       string  `xml:"title"`
+		Link        string  `xml:"link"`
+		GUID        rssGUID `xml:"guid"`
+		PubDate     string  `xml:"pubDate"`
+		Description string  `xml:"description"`
+	}
+
+	rssGUID struct {
+		Value       string `xml:",chardata"`
+		IsPermaLink bool   `xml:"isPermaLink,attr"`
+	}
+)
+
+// Returns the data sources matching the filters of the request, newest first
+func feedDatasources(r *http.Request) []Datasource {
+	dss := getFilteredDSs(newFilter(r))
+	sort.SliceStable(dss, func(i, j int) bool { return dss[i].Create_date.After(dss[j].Create_date) })
+	return dss
+}
+
+// Title of the feed, mentioning the filters applied
+func feedTitle(r *http.Request) string {
+	var filters []string
+	for _, key := range []string{"prefix", "host", "instance", "logfile", "upstream"} {
+		if v := r.URL.Query().Get(key); len(v) > 0 {
+			filters = append(filters, fmt.Sprintf("%v %v", key, v))
+		}
+	}
+	if len(filters) == 0 {
+		return "Graphite News"
+	}
+	return fmt.Sprintf("Graphite News (%v)", strings.Join(filters, ", "))
+}
+
+// URL the feed was requested on, used as its identity
+func feedURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return fmt.Sprintf("%v://%v%v", scheme, r.Host, r.URL.RequestURI())
+}
+
+// Describes where and how a data source got created
+func feedSummary(ds Datasource) string {
+	summary := fmt.Sprintf("New data source %v created at %v", ds.Name, ds.Create_date.Format(time.RFC1123))
+	if len(ds.Params) > 0 {
+		summary += fmt.Sprintf(" with %v", ds.Params)
+	}
+	if len(ds.Instance) > 0 || len(ds.Host) > 0 {
+		summary += fmt.Sprintf(" by %v on %v", ds.Instance, ds.Host)
+	}
+	return summary
+}
+
+func atomHandler(w http.ResponseWriter, r *http.Request) {
+	dss := feedDatasources(r)
+	self := feedURL(r)
+	feed := atomFeed{
+		Title:   feedTitle(r),
+		ID:      self,
+		Updated: time.Now().Format(time.RFC3339),
+		Links:   []atomLink{{Href: self, Rel: "self"}, {Href: C.GraphiteURL}},
+		Author:  atomAuthor{Name: "graphite-news"},
+	}
+	if len(dss) > 0 {
+		feed.Updated = dss[0].Create_date.Format(time.RFC3339)
+	}
+	for _, ds := range dss {
+		feed.Entries = append(feed.Entries, atomEntry{
+			Title:   ds.Name,
+			ID:      fmt.Sprintf("urn:graphite-news:%v:%v", url.QueryEscape(ds.Name), ds.Create_date.Unix()),
+			Updated: ds.Create_date.Format(time.RFC3339),
+			Link:    atomLink{Href: dsGraphURL(ds)},
+			Summary: feedSummary(ds),
+		})
+	}
+	writeFeed(w, "application/atom+xml", feed)
+}
+
+func rssHandler(w http.ResponseWriter, r *http.Request) {
+	dss := feedDatasources(r)
+	feed := rssFeed{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:       feedTitle(r),
+			Link:        C.GraphiteURL,
+			Description: "New data sources in Graphite",
+		},
+	}
+	if len(dss) > 0 {
+		feed.Channel.LastBuild = dss[0].Create_date.Format(time.RFC1123Z)
+	}
+	for _, ds := range dss {
+		feed.Channel.Items = append(feed.Channel.Items, rssItem{
+			Title:       ds.Name,
+			Link:        dsGraphURL(ds),
+			GUID:        rssGUID{Value: fmt.Sprintf("%v@%v", ds.Name, ds.Create_date.Unix())},
+			PubDate:     ds.Create_date.Format(time.RFC1123Z),
+			Description: feedSummary(ds),
+		})
+	}
+	writeFeed(w, "application/rss+xml", feed)
+}
+
+func writeFeed(w http.ResponseWriter, contentType string, feed interface{}) {
+	out, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", contentType+"; charset=utf-8")
+	w.Write([]byte(xml.Header))
+	w.Write(out)
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFeeds(t *testing.T) {
+	State.Vals = nil // start fresh
+	defer func() { State.Vals = nil }()
+	now := time.Now()
+	addItemToState(Datasource{Name: "app.payments.latency", Create_date: now.Add(-time.Hour), Params: "(archive=1)"})
+	addItemToState(Datasource{Name: "app.payments.count", Create_date: now})
+	addItemToState(Datasource{Name: "app.checkout.count", Create_date: now})
+
+	req, _ := http.NewRequest("GET", "/feed.atom?prefix=app.pay*", nil)
+	w := httptest.NewRecorder()
+	atomHandler(w, req)
+	var atom atomFeed
+	if err := xml.Unmarshal(w.Body.Bytes(), &atom); err != nil {
+		t.Fatal(fmt.Sprintf("Could not parse Atom feed: %v\n%v", err, w.Body.String()))
+	}
+	if len(atom.Entries) != 2 || atom.Entries[0].Title != "app.payments.count" || !strings.Contains(atom.Title, "prefix app.pay*") {
+		t.Fatal(fmt.Sprintf("Expected the 2 payments data sources newest first, got %+v", atom))
+	}
+	if atom.Entries[1].Link.Href != C.GraphiteURL+"/?target=app.payments.latency" || !strings.Contains(atom.Entries[1].Summary, "(archive=1)") {
+		t.Fatal(fmt.Sprintf("Entry should link to its graph and describe it: %+v", atom.Entries[1]))
+	}
+
+	req, _ = http.NewRequest("GET", "/feed.rss", nil)
+	w = httptest.NewRecorder()
+	rssHandler(w, req)
+	var rss rssFeed
+	if err := xml.Unmarshal(w.Body.Bytes(), &rss); err != nil {
+		t.Fatal(fmt.Sprintf("Could not parse RSS feed: %v\n%v", err, w.Body.String()))
+	}
+	if w.Header().Get("Content-Type") != "application/rss+xml; charset=utf-8" || len(rss.Channel.Items) != 3 || rss.Channel.Items[2].Title != "app.payments.latency" {
+		t.Fatal(fmt.Sprintf("Expected all 3 data sources in the RSS feed, got %+v", rss))
+	}
+	if _, err := time.Parse(time.RFC1123Z, rss.Channel.Items[0].PubDate); err != nil {
+		t.Fatal(fmt.Sprintf("Invalid pubDate: %v", err))
+	}
+}
@@ -0,0 +1,151 @@
+package main
+
+// Grafana integration. With a Grafana base URL (-gf) every data source gets
+// a link to it in Grafana's Explore (GrafanaURL), using the Graphite data
+// source with UID -gu, or the GrafanaUID of its backend.
+//
+// /grafana/ also speaks the search and annotations part of the SimpleJSON
+// (JSON API) data source contract, so creates can be overlaid on Grafana
+// panels: point a JSON API data source at http://graphite-news:2934/grafana
+// and add an annotation query with a prefix like app.payments.
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+type (
+	grafanaSearchRequest struct {
+		Target string `json:"target"`
+	}
+
+	grafanaAnnotationRequest struct {
+		Range struct {
+			From time.Time `json:"from"`
+			To   time.Time `json:"to"`
+		} `json:"range"`
+		Annotation json.RawMessage `json:"annotation"` // has the query, gets echoed back
+	}
+
+	grafanaAnnotation struct {
+		Annotation json.RawMessage `json:"annotation"` // echoes the request
+		Time       int64           `json:"time"`       // ms since epoch
+		Title      string          `json:"title"`
+		Text       string          `json:"text"`
+		Tags       []string        `json:"tags"`
+	}
+)
+
+// Maximum number of names returned by a search
+const maxGrafanaSearch = 1000
+
+// Link to a data source in Grafana's Explore, "" without -gf
+func grafanaLink(ds Datasource) string {
+	if len(C.grafanaURL) == 0 {
+		return ""
+	}
+	uid := C.grafanaUID
+	for _, b := range backends {
+		if b.Name == ds.Backend && len(b.GrafanaUID) > 0 {
+			uid = b.GrafanaUID
+		}
+	}
+	left, _ := json.Marshal(map[string]interface{}{
+		"datasource": uid,
+		"queries":    []map[string]string{{"refId": "A", "target": ds.Name}},
+		"range":      map[string]string{"from": "now-24h", "to": "now"},
+	})
+	return C.grafanaURL + "/explore?left=" + url.QueryEscape(string(left))
+}
+
+// Checks a name against a search or annotation query: a graphite style
+// glob (see matchesPrefix) or any part of the name. Empty matches all.
+func grafanaMatch(query string, name string) bool {
+	return len(query) == 0 || matchesPrefix(query, name) || strings.Contains(name, query)
+}
+
+func grafanaHandler(w http.ResponseWriter, r *http.Request) {
+	switch strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/grafana"), "/") {
+	case "":
+		// Grafana's "Save & test"
+		w.Write([]byte("OK"))
+	case "/search":
+		if allowMethods(w, r, "POST") {
+			grafanaSearch(w, r)
+		}
+	case "/annotations":
+		if allowMethods(w, r, "POST") {
+			grafanaAnnotations(w, r)
+		}
+	default:
+		writeAPIError(w, http.StatusNotFound, "not_found", "Only search and annotations are supported: "+r.URL.Path)
+	}
+}
+
+// Names of data sources, for the query editor
+func grafanaSearch(w http.ResponseWriter, r *http.Request) {
+	var req grafanaSearchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeAPIError(w, http.StatusBadRequest, "bad_request", err.Error())
+		return
+	}
+
+	seen := map[string]bool{}
+	names := []string{}
+	add := func(name string) {
+		if !seen[name] && len(names) < maxGrafanaSearch && grafanaMatch(req.Target, name) {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	for _, ds := range getFilteredDSs(dsFilter{}) {
+		add(ds.Name)
+	}
+	for _, e := range history.since(time.Time{}) {
+		add(e.Name)
+	}
+	sort.Strings(names)
+	writeAPIJSON(w, http.StatusOK, names)
+}
+
+// Creates within the requested range as annotations, the query of the
+// annotation picks the data sources
+func grafanaAnnotations(w http.ResponseWriter, r *http.Request) {
+	var req grafanaAnnotationRequest
+	var annotation struct {
+		Query string `json:"query"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeAPIError(w, http.StatusBadRequest, "bad_request", err.Error())
+		return
+	}
+	json.Unmarshal(req.Annotation, &annotation)
+	if req.Range.To.IsZero() {
+		req.Range.To = time.Now()
+	}
+
+	result := []grafanaAnnotation{}
+	for _, e := range history.since(req.Range.From) {
+		if e.Create_date.After(req.Range.To) || !grafanaMatch(annotation.Query, e.Name) {
+			continue
+		}
+		tags := []string{}
+		for _, tag := range []string{e.Host, e.Instance} {
+			if len(tag) > 0 {
+				tags = append(tags, tag)
+			}
+		}
+		result = append(result, grafanaAnnotation{
+			Annotation: req.Annotation,
+			Time:       e.Create_date.UnixNano() / int64(time.Millisecond),
+			Title:      "New data source",
+			Text:       e.Name,
+			Tags:       tags,
+		})
+	}
+	writeAPIJSON(w, http.StatusOK, result)
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestGrafanaLink(t *testing.T) {
+	defer func(saved configuration) { C = saved }(C)
+	if link := grafanaLink(Datasource{Name: "app.count"}); len(link) > 0 {
+		t.Fatal(fmt.Sprintf("Expected no link without -gf, got %v", link))
+	}
+
+	C.grafanaURL = "http://grafana:3000"
+	C.grafanaUID = "graphite"
+	backends = []graphiteBackend{{Name: "eu", URL: "http://graphite-eu", GrafanaUID: "graphite-eu"}}
+	defer func() { backends = nil }()
+
+	for backend, uid := range map[string]string{"default": "graphite", "eu": "graphite-eu"} {
+		link := grafanaLink(Datasource{Name: "app.payments.count", Backend: backend})
+		u, err := url.Parse(link)
+		if err != nil || !strings.HasPrefix(link, "http://grafana:3000/explore?left=") {
+			t.Fatal(fmt.Sprintf("Invalid Explore link: %v", link))
+		}
+		var left struct {
+			Datasource string
+			Queries    []map[string]string
+		}
+		json.Unmarshal([]byte(u.Query().Get("left")), &left)
+		if left.Datasource != uid || left.Queries[0]["target"] != "app.payments.count" {
+			t.Fatal(fmt.Sprintf("Expected Explore of app.payments.count in %v, got %+v", uid, left))
+		}
+	}
+}
+
+func TestGrafanaEndpoints(t *testing.T) {
+	saved := history
+	history = &createHistory{&sync.RWMutex{}, nil}
+	defer func() { history = saved }()
+	now := time.Now().Truncate(time.Second)
+	history.add(Datasource{Name: "app.payments.count", Create_date: now.Add(-2 * time.Hour), Origin: Origin{Host: "carbon1"}})
+	history.add(Datasource{Name: "app.payments.latency", Create_date: now.Add(-time.Hour), Origin: Origin{Host: "carbon1", Instance: "carbon-cache-a"}})
+	history.add(Datasource{Name: "app.checkout.count", Create_date: now.Add(-time.Hour)})
+
+	post := func(path string, body string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("POST", path, strings.NewReader(body))
+		w := httptest.NewRecorder()
+		grafanaHandler(w, req)
+		return w
+	}
+
+	var names []string
+	w := post("/grafana/search", `{"target": "payments"}`)
+	if json.Unmarshal(w.Body.Bytes(), &names); fmt.Sprint(names) != "[app.payments.count app.payments.latency]" {
+		t.Fatal(fmt.Sprintf("Unexpected search result: %v", w.Body.String()))
+	}
+	w = post("/grafana/search", `{"target": "app.*.count"}`)
+	if json.Unmarshal(w.Body.Bytes(), &names); fmt.Sprint(names) != "[app.checkout.count app.payments.count]" {
+		t.Fatal(fmt.Sprintf("Unexpected search result: %v", w.Body.String()))
+	}
+
+	from := now.Add(-90 * time.Minute).UTC().Format(time.RFC3339)
+	w = post("/grafana/annotations", fmt.Sprintf(`{"range": {"from": "%v", "to": "%v"}, "annotation": {"name": "creates", "query": "app.payments", "enable": true}}`, from, now.UTC().Format(time.RFC3339)))
+	var annotations []grafanaAnnotation
+	if err := json.Unmarshal(w.Body.Bytes(), &annotations); err != nil || len(annotations) != 1 {
+		t.Fatal(fmt.Sprintf("Expected one annotation, got %v (%v)", w.Body.String(), err))
+	}
+	a := annotations[0]
+	if a.Text != "app.payments.latency" || a.Time != now.Add(-time.Hour).Unix()*1000 || fmt.Sprint(a.Tags) != "[carbon1 carbon-cache-a]" || !strings.Contains(string(a.Annotation), `"name":"creates"`) {
+		t.Fatal(fmt.Sprintf("Unexpected annotation: %+v", a))
+	}
+
+	if w = post("/grafana/query", `{}`); w.Code != http.StatusNotFound {
+		t.Fatal(fmt.Sprintf("Expected 404 for unsupported query, got %v", w.Code))
+	}
+	req, _ := http.NewRequest("GET", "/grafana/", nil)
+	w = httptest.NewRecorder()
+	grafanaHandler(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatal(fmt.Sprintf("Expected test connection to succeed, got %v", w.Code))
+	}
+}
@@ -0,0 +1,127 @@
+package main
+
+// Keeps the metrics of makeHandler bounded. Requests are timed per route
+// they were registered on (/api/v1/, not every /api/v1/datasources/{name}),
+// methods net/http doesn't know end up as OTHER, and once maxTrackedRoutes
+// method and route pairs are tracked any new ones are timed as __other. That
+// way scanners trying random URLs or methods can't create new metrics, here
+// or in Graphite with -r.
+//
+// Responses are also counted per status code, as responses.GET.json.404.
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+type (
+	// Remembers the status code a handler wrote
+	statusWriter struct {
+		http.ResponseWriter
+		status int
+	}
+
+	routeSet struct {
+		*sync.Mutex
+		max    int
+		routes map[string]string // metric name to route and method, see track
+	}
+)
+
+const (
+	maxTrackedRoutes = 200
+
+	otherRoute  = "__other"
+	otherMethod = "OTHER"
+)
+
+var (
+	trackedRoutes = newRouteSet(maxTrackedRoutes)
+
+	knownMethods = []string{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}
+)
+
+func (w *statusWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Streaming exports flush, see writeExport
+func (w *statusWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Status code written, a handler that writes nothing is a 200
+func (w *statusWriter) code() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
+
+func newRouteSet(max int) *routeSet {
+	return &routeSet{&sync.Mutex{}, max, map[string]string{}}
+}
+
+// For metric names, escape any natural dots in the route, then replace the
+// slashes with a dot and make sure it doesn't start or end with a dot.
+func routeMetricName(route string) string {
+	name := strings.Replace(route, ".", "_", -1)
+	name = strings.Replace(name, "/", ".", -1)
+	name = strings.Trim(name, ".")
+	if len(name) == 0 {
+		name = "index_html"
+	}
+	return name
+}
+
+// Method as it goes into metric names, OTHER for anything unusual
+func metricMethod(method string) string {
+	if containsString(knownMethods, method) {
+		return method
+	}
+	return otherMethod
+}
+
+// Metric name to time a request to route with, METHOD.route, or
+// METHOD.__other once the set is full
+func (s *routeSet) track(method string, route string) string {
+	method = metricMethod(method)
+	name := fmt.Sprintf("%s.%s", method, routeMetricName(route))
+	s.Lock()
+	defer s.Unlock()
+	if _, ok := s.routes[name]; ok {
+		return name
+	}
+	if len(s.routes) >= s.max {
+		name = fmt.Sprintf("%s.%s", method, otherRoute)
+		route = otherRoute
+	}
+	s.routes[name] = method + " " + route
+	return name
+}
+
+// Method and route of a metric name handed out by track
+func (s *routeSet) route(name string) (string, string, bool) {
+	s.Lock()
+	defer s.Unlock()
+	v, ok := s.routes[name]
+	if !ok {
+		return "", "", false
+	}
+	parts := strings.SplitN(v, " ", 2)
+	return parts[0], parts[1], true
+}
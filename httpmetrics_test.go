@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+	"github.com/rcrowley/go-metrics"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRouteMetricName(t *testing.T) {
+	type testpair struct {
+		route string
+		name  string
+	}
+	var testCases = []testpair{
+		{"/", "index_html"},
+		{"/json/", "json"},
+		{"/api/v1/", "api.v1"},
+		{"/feed.atom", "feed_atom"},
+	}
+	for _, test := range testCases {
+		if name := routeMetricName(test.route); name != test.name {
+			t.Fatal(fmt.Sprintf("Expected %v to be named %v, got %v", test.route, test.name, name))
+		}
+	}
+}
+
+func TestRouteSet(t *testing.T) {
+	s := newRouteSet(2)
+	type testpair struct {
+		method string
+		route  string
+		name   string
+	}
+	var testCases = []testpair{
+		{"GET", "/json/", "GET.json"},
+		{"PROPFIND", "/json/", "OTHER.json"},
+		{"GET", "/json/", "GET.json"},
+		{"GET", "/tree/", "GET.__other"},
+		{"POST", "/", "POST.__other"},
+	}
+	for _, test := range testCases {
+		if name := s.track(test.method, test.route); name != test.name {
+			t.Fatal(fmt.Sprintf("Expected %v %v to be tracked as %v, got %v", test.method, test.route, test.name, name))
+		}
+	}
+	if method, route, ok := s.route("OTHER.json"); !ok || method != "OTHER" || route != "/json/" {
+		t.Fatal(fmt.Sprintf("Expected OTHER.json to be OTHER /json/, got %v %v %v", method, route, ok))
+	}
+}
+
+func TestMakeHandler(t *testing.T) {
+	defer func(saved *routeSet) { trackedRoutes = saved }(trackedRoutes)
+	trackedRoutes = newRouteSet(maxTrackedRoutes)
+
+	h := makeHandler("/datasource/", func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := w.(http.Flusher); !ok {
+			t.Fatal("Expected handlers to be able to flush")
+		}
+		errorHandler(w, r, http.StatusNotFound)
+	})
+	for _, path := range []string{"/datasource/a", "/datasource/b", "/datasource/c"} {
+		req, _ := http.NewRequest("GET", path, nil)
+		h(httptest.NewRecorder(), req)
+	}
+
+	timer := metrics.DefaultRegistry.Get("GET.datasource")
+	if timer == nil || timer.(metrics.Timer).Count() < 3 {
+		t.Fatal(fmt.Sprintf("Expected the requests to be timed on their route, got %v", timer))
+	}
+	if metrics.DefaultRegistry.Get("GET.datasource.a") != nil {
+		t.Fatal("Expected no timer per URL")
+	}
+	counter := metrics.DefaultRegistry.Get("responses.GET.datasource.404")
+	if counter == nil || counter.(metrics.Counter).Count() < 3 {
+		t.Fatal(fmt.Sprintf("Expected the 404s to be counted, got %v", counter))
+	}
+}
@@ -0,0 +1,164 @@
+package main
+
+// Lets other hosts push create events towards us, so that one central
+// graphite-news can show what is happening on many carbon hosts. Either
+// ship raw carbon log lines (f.ex. `tail -F creates.log | nc news 2935`
+// or rsyslog) or POST data sources as JSON to /ingest/.
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/rcrowley/go-metrics"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// Maximum size of a single UDP datagram we are willing to read
+	maxDatagramSize = 65536
+
+	// Maximum size of a JSON body POSTed to /ingest/
+	maxIngestBody = 10 << 20
+)
+
+// Starts the TCP and UDP listeners for raw carbon log lines, if configured
+func listenForLines(c chan string) {
+	if len(C.ingestAddr) == 0 {
+		return
+	}
+	l := log.New(os.Stdout, "ingest	", myLogFormat)
+
+	ln, err := net.Listen("tcp", C.ingestAddr)
+	if err != nil {
+		c <- fmt.Sprintf("%s", err)
+		return
+	}
+	pc, err := net.ListenPacket("udp", C.ingestAddr)
+	if err != nil {
+		c <- fmt.Sprintf("%s", err)
+		return
+	}
+
+	l.Printf("Listening for carbon log lines on %v (tcp and udp)", C.ingestAddr)
+	go serveLinesUDP(c, pc)
+	serveLinesTCP(c, ln)
+}
+
+func serveLinesTCP(c chan string, ln net.Listener) {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			c <- fmt.Sprintf("%s", err)
+			return
+		}
+		go readLines(conn)
+	}
+}
+
+// Parses every line coming in over a single TCP connection, until the
+// remote end hangs up.
+func readLines(conn net.Conn) {
+	l := log.New(os.Stdout, "ingest	", myLogFormat)
+	m := metrics.GetOrRegisterCounter("ingest.connections", metrics.DefaultRegistry)
+	m.Inc(1)
+	defer conn.Close()
+
+	o := Origin{Logfile: "tcp://" + C.ingestAddr, Host: remoteHost(conn.RemoteAddr())}
+	scanner := bufio.NewScanner(conn)
+	for scanner.Scan() {
+		parseLine(scanner.Text(), o)
+	}
+	if err := scanner.Err(); err != nil {
+		l.Printf("Error reading from %v: %v", conn.RemoteAddr(), err)
+	}
+}
+
+// Every datagram can hold one or more newline separated log lines
+func serveLinesUDP(c chan string, pc net.PacketConn) {
+	buf := make([]byte, maxDatagramSize)
+	for {
+		n, addr, err := pc.ReadFrom(buf)
+		if err != nil {
+			c <- fmt.Sprintf("%s", err)
+			return
+		}
+		o := Origin{Logfile: "udp://" + C.ingestAddr, Host: remoteHost(addr)}
+		for _, line := range strings.Split(string(buf[:n]), "\n") {
+			if len(strings.TrimSpace(line)) > 0 {
+				parseLine(line, o)
+			}
+		}
+	}
+}
+
+// Strips the port number from an address, leaving just the IP
+func remoteHost(addr net.Addr) string {
+	host, _, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return addr.String()
+	}
+	return host
+}
+
+// Accepts a single data source, or a list of them, as JSON. Only Name is
+// required, Create_date defaults to now and Host to the IP of the client.
+func ingestHandler(w http.ResponseWriter, r *http.Request) {
+	l := log.New(os.Stdout, "ingest	", myLogFormat)
+	m := metrics.GetOrRegisterCounter("ingest.json_datasources", metrics.DefaultRegistry)
+
+	if !C.AllowIngest {
+		l.Printf("INGEST called, ignoring b/c not enabled: %v:%v\n", r.Method, r.URL)
+		http.NotFound(w, r)
+		return
+	}
+	if !allowMethods(w, r, "POST") {
+		return
+	}
+
+	var dss []Datasource
+	r.Body = http.MaxBytesReader(w, r.Body, maxIngestBody)
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '{' {
+		var ds Datasource
+		err = json.Unmarshal(data, &ds)
+		dss = append(dss, ds)
+	} else {
+		err = json.Unmarshal(data, &dss)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	host, _, _ := net.SplitHostPort(r.RemoteAddr)
+	for _, ds := range dss {
+		if len(ds.Name) == 0 {
+			continue
+		}
+		if ds.Create_date.IsZero() {
+			ds.Create_date = time.Now()
+		}
+		if len(ds.Host) == 0 {
+			ds.Host = host
+		}
+		// Never trust file locations or upstreams from the outside world,
+		// these are only used for deleting (and forwarding deletes).
+		ds.filename = ""
+		ds.Upstream = ""
+		addItemToState(ds)
+		m.Inc(1)
+	}
+	w.Write(nil)
+}
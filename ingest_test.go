@@ -0,0 +1,103 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestIngestLinesOverTCP(t *testing.T) {
+	State.Vals = nil // start fresh
+	defer func() { State.Vals = nil }()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(fmt.Sprintf("Could not listen: %v", err))
+	}
+	defer ln.Close()
+	go serveLinesTCP(make(chan string, 1), ln)
+
+	conn, err := net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatal(fmt.Sprintf("Could not connect: %v", err))
+	}
+	fmt.Fprintln(conn, "13/09/2014 23:10:56 :: [creates] creating database file /opt/graphite/storage/whisper/remote/tcp/a.wsp (archive=[(60, 525600)] xff=None agg=None)")
+	fmt.Fprintln(conn, "not a create line")
+	conn.Close()
+
+	// the line gets parsed asynchronously, give it a moment
+	for i := 0; i < 100 && len(getDSbyName("remote.tcp.a").Name) == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	ds := getDSbyName("remote.tcp.a")
+	if ds.Host != "127.0.0.1" {
+		t.Fatal(fmt.Sprintf("Line sent over TCP did not end up in state with remote host: %+v", ds))
+	}
+}
+
+func TestIngestJSON(t *testing.T) {
+	State.Vals = nil // start fresh
+	defer func() { State.Vals = nil }()
+	C.AllowIngest = true
+	defer func() { C.AllowIngest = false }()
+
+	body := `[{"Name": "remote.json.a", "Instance": "carbon-cache-a"}, {"Name": "remote.json.b", "Host": "carbon1", "Upstream": "http://internal-host"}, {"Name": ""}]`
+	req, _ := http.NewRequest("POST", "/ingest/", strings.NewReader(body))
+	req.RemoteAddr = "10.0.0.1:1234"
+	w := httptest.NewRecorder()
+	ingestHandler(w, req)
+
+	if w.Code != http.StatusOK || len(State.Vals) != 2 {
+		t.Fatal(fmt.Sprintf("Expected 2 data sources to be ingested, got %v (status %v)", len(State.Vals), w.Code))
+	}
+	if ds := getDSbyName("remote.json.a"); ds.Host != "10.0.0.1" || ds.Create_date.IsZero() {
+		t.Fatal(fmt.Sprintf("Ingested data source did not get defaults filled in: %+v", ds))
+	}
+	if ds := getDSbyName("remote.json.b"); ds.Host != "carbon1" {
+		t.Fatal(fmt.Sprintf("Ingested data source lost its host: %+v", ds))
+	}
+	if ds := getDSbyName("remote.json.b"); len(ds.Upstream) > 0 {
+		t.Fatal(fmt.Sprintf("Ingested data source kept the upstream it was posted with: %+v", ds))
+	}
+
+	req, _ = http.NewRequest("POST", "/ingest/", strings.NewReader(`{"Name": `))
+	w = httptest.NewRecorder()
+	ingestHandler(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Fatal(fmt.Sprintf("Invalid JSON should result in a bad request, got %v", w.Code))
+	}
+}
+
+func TestIngestRejects(t *testing.T) {
+	State.Vals = nil // start fresh
+	defer func() { State.Vals = nil }()
+	defer func(saved configuration) { C = saved }(C)
+
+	C.AllowIngest = false
+	req, _ := http.NewRequest("POST", "/ingest/", strings.NewReader(`{"Name": "remote.json.a"}`))
+	w := httptest.NewRecorder()
+	ingestHandler(w, req)
+	if w.Code != http.StatusNotFound || len(State.Vals) > 0 {
+		t.Fatal(fmt.Sprintf("Ingest while disabled should be a 404, got %v", w.Code))
+	}
+
+	C.AllowIngest = true
+	req, _ = http.NewRequest("GET", "/ingest/", nil)
+	w = httptest.NewRecorder()
+	ingestHandler(w, req)
+	if w.Code != http.StatusMethodNotAllowed || w.Header().Get("Allow") != "POST" {
+		t.Fatal(fmt.Sprintf("GET on ingest should be a 405 allowing POST, got %v (Allow: %q)", w.Code, w.Header().Get("Allow")))
+	}
+
+	big := `[{"Name": "remote.json.big"}` + strings.Repeat(" ", maxIngestBody) + `]`
+	req, _ = http.NewRequest("POST", "/ingest/", strings.NewReader(big))
+	w = httptest.NewRecorder()
+	ingestHandler(w, req)
+	if w.Code != http.StatusRequestEntityTooLarge || len(State.Vals) > 0 {
+		t.Fatal(fmt.Sprintf("Too large a body should be rejected, got %v", w.Code))
+	}
+}
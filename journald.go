@@ -0,0 +1,138 @@
+package main
+
+// Follows the systemd journal for carbon units that do not log to files.
+// Runs `journalctl -o json -f` as a subprocess and feeds every message
+// through the same parsing as tailLogfile does. Optionally remembers the
+// journal cursor so a restart picks up where we left off.
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+type (
+	// A single entry as written by journalctl -o json. MESSAGE is a string,
+	// unless it holds non-UTF8 data in which case it is a list of bytes.
+	journalEntry struct {
+		Cursor   string          `json:"__CURSOR"`
+		Hostname string          `json:"_HOSTNAME"`
+		Unit     string          `json:"_SYSTEMD_UNIT"`
+		Message  json.RawMessage `json:"MESSAGE"`
+	}
+)
+
+// How often to write the journal cursor to disk at most
+const journalCursorInterval = time.Second
+
+// Returns the message of a journal entry as a string
+func (e journalEntry) message() string {
+	var s string
+	if err := json.Unmarshal(e.Message, &s); err == nil {
+		return s
+	}
+	var b []byte
+	var ints []int
+	if err := json.Unmarshal(e.Message, &ints); err == nil {
+		for _, i := range ints {
+			b = append(b, byte(i))
+		}
+	}
+	return string(b)
+}
+
+// Where the cursor for a unit is stored, empty if not persisting cursors
+func journalCursorFile(unit string) string {
+	if len(C.journalCursorDir) == 0 {
+		return ""
+	}
+	return filepath.Join(C.journalCursorDir, unit+".cursor")
+}
+
+func followJournals(c chan string) {
+	for _, unit := range C.journalUnits {
+		go followJournal(c, unit)
+	}
+}
+
+// Runs journalctl for a single unit until it exits
+func followJournal(c chan string, unit string) {
+	l := log.New(os.Stdout, "journal	", myLogFormat)
+
+	args := []string{"-o", "json", "-f", "-u", unit}
+	cursorFile := journalCursorFile(unit)
+	cursor, err := ioutil.ReadFile(cursorFile)
+	if len(cursorFile) > 0 && err == nil && len(cursor) > 0 {
+		args = append(args, "--after-cursor="+strings.TrimSpace(string(cursor)))
+	} else {
+		args = append(args, "--no-tail")
+	}
+
+	cmd := exec.Command("journalctl", args...)
+	stdout, err := cmd.StdoutPipe()
+	if err == nil {
+		err = cmd.Start()
+	}
+	if err != nil {
+		c <- fmt.Sprintf("%s", err)
+		return
+	}
+
+	l.Printf("Following journal for unit:[%s]\n", unit)
+	readJournal(stdout, unit, cursorFile)
+	c <- fmt.Sprintf("journalctl for %v stopped: %v", unit, cmd.Wait())
+}
+
+// Parses the output of journalctl -o json, saving the cursor of the last
+// processed entry every so often (and once more at the end).
+func readJournal(r io.Reader, unit string, cursorFile string) {
+	l := log.New(os.Stdout, "journal	", myLogFormat)
+	var cursor string
+	var saved time.Time
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var entry journalEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			l.Printf("Could not parse journal entry for %v: %v", unit, err)
+			continue
+		}
+		if len(entry.Unit) == 0 {
+			entry.Unit = unit
+		}
+
+		parseLine(entry.message(), Origin{Logfile: "journald://" + unit, Instance: entry.Unit, Host: entry.Hostname})
+
+		cursor = entry.Cursor
+		if len(cursorFile) > 0 && time.Since(saved) > journalCursorInterval {
+			saveJournalCursor(cursorFile, cursor)
+			saved = time.Now()
+		}
+	}
+	if len(cursorFile) > 0 && len(cursor) > 0 {
+		saveJournalCursor(cursorFile, cursor)
+	}
+}
+
+// Writes the cursor to a temporary file first, then moves it in place so
+// that we never end up with a half written cursor.
+func saveJournalCursor(cursorFile string, cursor string) {
+	l := log.New(os.Stdout, "journal	", myLogFormat)
+	tmp := cursorFile + ".tmp"
+	err := ioutil.WriteFile(tmp, []byte(cursor), 0644)
+	if err == nil {
+		err = os.Rename(tmp, cursorFile)
+	}
+	if err != nil {
+		l.Printf("Could not save journal cursor to %v: %v", cursorFile, err)
+	}
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReadJournal(t *testing.T) {
+	State.Vals = nil // start fresh
+	defer func() { State.Vals = nil }()
+
+	dir, _ := ioutil.TempDir("", "graphite-news-journal")
+	defer os.RemoveAll(dir)
+	cursorFile := filepath.Join(dir, "carbon-cache@a.service.cursor")
+
+	// the second message is "creating database file .../b.wsp ..." as bytes
+	msg := "creating database file /opt/graphite/storage/whisper/journal/b.wsp (archive=[(60, 525600)] xff=None agg=None)"
+	var bytes []string
+	for _, b := range []byte(msg) {
+		bytes = append(bytes, fmt.Sprintf("%d", b))
+	}
+	journal := `{"__CURSOR": "s=1", "_HOSTNAME": "carbon1", "_SYSTEMD_UNIT": "carbon-cache@a.service", "MESSAGE": "[creates] creating database file /opt/graphite/storage/whisper/journal/a.wsp (archive=[(60, 525600)] xff=None agg=None)"}
+not json
+{"__CURSOR": "s=2", "_HOSTNAME": "carbon1", "MESSAGE": [` + strings.Join(bytes, ",") + `]}
+`
+	readJournal(strings.NewReader(journal), "carbon-cache@a.service", cursorFile)
+
+	for _, name := range []string{"journal.a", "journal.b"} {
+		if ds := getDSbyName(name); ds.Host != "carbon1" || ds.Instance != "carbon-cache@a.service" {
+			t.Fatal(fmt.Sprintf("Journal entry did not end up in state with the right origin: %v %+v", name, ds))
+		}
+	}
+
+	cursor, _ := ioutil.ReadFile(cursorFile)
+	if string(cursor) != "s=2" {
+		t.Fatal(fmt.Sprintf("Expected cursor of last journal entry to be saved, found [%s]", cursor))
+	}
+}
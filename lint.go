@@ -0,0 +1,314 @@
+package main
+
+// Checks every new data source against naming conventions. The rules are
+// configured in a JSON file (-lr), for example:
+//
+//	{"Rules": [
+//	   {"Name": "lowercase", "Type": "lowercase"},
+//	   {"Name": "environment", "Type": "prefix", "Values": ["prod", "staging", "dev"], "Severity": "hard"},
+//	   {"Name": "depth", "Type": "maxdepth", "Max": 8},
+//	   {"Name": "suffix", "Type": "suffix", "Values": ["count", "mean", "p99"], "Filters": ["prod.app"]}
+//	 ],
+//	 "Notify": true, "AutoDelete": false}
+//
+// Violations are attached to the data source (and so show up in /json/ and
+// the UI). With Notify they are sent as alerts to the webhooks and chat
+// routes, one per prefix per minute at most and not again within the
+// alertCooldown of spikes.go. With AutoDelete the whisper file of a data
+// source with a hard violation gets deleted straight away.
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/rcrowley/go-metrics"
+	"io/ioutil"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+type (
+	// A single naming rule
+	lintRule struct {
+		Name     string
+		Type     string   // lowercase, nospaces, maxdepth, prefix, suffix, regex or noids
+		Values   []string // allowed first segments (prefix) or last segments (suffix)
+		Max      int      // maximum number of segments (maxdepth)
+		Pattern  string   // regular expression the name has to match (regex)
+		Severity string   // "warn" (default) or "hard"
+		Filters  []string // only check names under these prefixes, see matchesPrefix
+
+		re *regexp.Regexp
+	}
+
+	// The rules and what to do on violations
+	lintPolicy struct {
+		Rules      []lintRule
+		Notify     bool // send an alert for every violation
+		AutoDelete bool // delete the whisper file on hard violations
+	}
+
+	// A rule a data source does not live up to
+	Violation struct {
+		Rule     string
+		Severity string
+		Message  string
+	}
+
+	deletedNames struct {
+		*sync.Mutex
+		names map[string]time.Time
+	}
+
+	// Violations of the current minute per prefix, to alert on once a minute
+	lintReport struct {
+		*sync.Mutex
+		depth    int
+		counts   map[string]int
+		examples map[string]string    // first offender per prefix, with its reasons
+		alerted  map[string]time.Time // last alert per prefix, to not repeat ourselves
+	}
+)
+
+var (
+	// Rules in use, nil when not linting. Set once before any data sources
+	// come in, so no locking needed.
+	policy *lintPolicy
+
+	lintAlerts = newLintReport(2)
+
+	// Names AutoDelete deleted, and when
+	autoDeleted = &deletedNames{&sync.Mutex{}, map[string]time.Time{}}
+)
+
+// Time to leave a data source alone after AutoDelete deleted it
+const autoDeleteCooldown = time.Hour
+
+func loadLintPolicy(file string) (*lintPolicy, error) {
+	var p lintPolicy
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("Could not parse %v: %v", file, err)
+	}
+
+	for i := range p.Rules {
+		r := &p.Rules[i]
+		if len(r.Name) == 0 {
+			r.Name = r.Type
+		}
+		if len(r.Severity) == 0 {
+			r.Severity = "warn"
+		}
+		if r.Severity != "warn" && r.Severity != "hard" {
+			return nil, fmt.Errorf("Rule %v: unknown Severity '%v'", r.Name, r.Severity)
+		}
+		switch r.Type {
+		case "lowercase", "nospaces", "noids":
+		case "maxdepth":
+			if r.Max < 1 {
+				return nil, fmt.Errorf("Rule %v: maxdepth needs a Max", r.Name)
+			}
+		case "prefix", "suffix":
+			if len(r.Values) == 0 {
+				return nil, fmt.Errorf("Rule %v: %v needs Values", r.Name, r.Type)
+			}
+		case "regex":
+			if r.re, err = regexp.Compile(r.Pattern); err != nil {
+				return nil, fmt.Errorf("Rule %v: %v", r.Name, err)
+			}
+		default:
+			return nil, fmt.Errorf("Rule %v: unknown Type '%v'", r.Name, r.Type)
+		}
+	}
+	return &p, nil
+}
+
+// Returns a message describing how the name breaks the rule, or "" if it
+// doesn't.
+func (r lintRule) check(name string) string {
+	segments := strings.Split(name, ".")
+	switch r.Type {
+	case "lowercase":
+		if name != strings.ToLower(name) {
+			return "name is not all lowercase"
+		}
+	case "nospaces":
+		if strings.ContainsAny(name, " \t") {
+			return "name contains spaces"
+		}
+	case "noids":
+		if pattern, _, _ := idPattern(name); len(pattern) > 0 {
+			return fmt.Sprintf("name contains IDs: %v", pattern)
+		}
+	case "maxdepth":
+		if len(segments) > r.Max {
+			return fmt.Sprintf("name has %v segments, at most %v allowed", len(segments), r.Max)
+		}
+	case "prefix":
+		if !containsString(r.Values, segments[0]) {
+			return fmt.Sprintf("name does not start with one of %v", r.Values)
+		}
+	case "suffix":
+		if !containsString(r.Values, segments[len(segments)-1]) {
+			return fmt.Sprintf("name does not end with one of %v", r.Values)
+		}
+	case "regex":
+		if !r.re.MatchString(name) {
+			return fmt.Sprintf("name does not match %v", r.Pattern)
+		}
+	}
+	return ""
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Checks a name against all rules that apply to it
+func (p *lintPolicy) check(name string) []Violation {
+	var violations []Violation
+	for _, r := range p.Rules {
+		if len(r.Filters) > 0 && !matchesAnyPrefix(r.Filters, name) {
+			continue
+		}
+		if msg := r.check(name); len(msg) > 0 {
+			violations = append(violations, Violation{Rule: r.Name, Severity: r.Severity, Message: msg})
+		}
+	}
+	return violations
+}
+
+// Lints a data source on its way into the State. Returns false if it
+// should not be added, because it got deleted for a hard violation.
+func lintDatasource(ds *Datasource) bool {
+	if policy == nil {
+		return true
+	}
+	l := log.New(os.Stdout, "lint	", myLogFormat)
+	m := metrics.GetOrRegisterCounter("lint.violations", metrics.DefaultRegistry)
+
+	ds.Violations = policy.check(ds.Name)
+	if len(ds.Violations) == 0 {
+		return true
+	}
+	m.Inc(int64(len(ds.Violations)))
+
+	hard := false
+	var reasons []string
+	for _, v := range ds.Violations {
+		reasons = append(reasons, fmt.Sprintf("%v (%v)", v.Message, v.Rule))
+		hard = hard || v.Severity == "hard"
+	}
+
+	// carbon recreates a deleted file on the next point, don't go round in
+	// circles deleting (and alerting on) it every time
+	deleted, recreated := false, false
+	if hard && policy.AutoDelete && len(ds.filename) > 0 {
+		if recreated = autoDeleted.recent(ds.Name, time.Now()); recreated {
+			reasons = append(reasons, "recreated after being deleted, leaving it")
+		} else if deleted = deleteFile(ds.filename); deleted {
+			autoDeleted.add(ds.Name, time.Now())
+			reasons = append(reasons, "whisper file deleted")
+		}
+	}
+	l.Printf("Data source %v violates naming policy: %v", ds.Name, strings.Join(reasons, ", "))
+
+	if policy.Notify && !recreated {
+		lintAlerts.add(ds.Name, strings.Join(reasons, ", "))
+	}
+	return !deleted
+}
+
+func (d *deletedNames) add(name string, now time.Time) {
+	d.Lock()
+	defer d.Unlock()
+	for n, t := range d.names {
+		if now.Sub(t) >= autoDeleteCooldown {
+			delete(d.names, n)
+		}
+	}
+	d.names[name] = now
+}
+
+// Returns true if the name got deleted within the cooldown
+func (d *deletedNames) recent(name string, now time.Time) bool {
+	d.Lock()
+	defer d.Unlock()
+	t, ok := d.names[name]
+	return ok && now.Sub(t) < autoDeleteCooldown
+}
+
+func newLintReport(depth int) *lintReport {
+	return &lintReport{&sync.Mutex{}, depth, map[string]int{}, map[string]string{}, map[string]time.Time{}}
+}
+
+func (r *lintReport) add(name string, reasons string) {
+	r.Lock()
+	defer r.Unlock()
+	parts := strings.SplitN(name, ".", r.depth+1)
+	if len(parts) > r.depth {
+		parts = parts[:r.depth]
+	}
+	prefix := strings.Join(parts, ".")
+	if r.counts[prefix] == 0 {
+		r.examples[prefix] = fmt.Sprintf("%v: %v", name, reasons)
+	}
+	r.counts[prefix]++
+}
+
+// Closes the current minute, returns an alert for every prefix with
+// violations that wasn't alerted on within the cooldown
+func (r *lintReport) tick(now time.Time) []alert {
+	r.Lock()
+	defer r.Unlock()
+	var alerts []alert
+	for prefix, last := range r.alerted {
+		if now.Sub(last) >= alertCooldown {
+			delete(r.alerted, prefix)
+		}
+	}
+	for prefix, count := range r.counts {
+		if _, ok := r.alerted[prefix]; ok {
+			continue
+		}
+		r.alerted[prefix] = now
+		alerts = append(alerts, alert{Time: now, Kind: "lint", Prefix: prefix, Count: count,
+			Reason: fmt.Sprintf("%v new data source(s) in the last minute, f.ex. %v", count, r.examples[prefix])})
+	}
+	r.counts = map[string]int{}
+	r.examples = map[string]string{}
+	sort.Slice(alerts, func(i, j int) bool { return alerts[i].Prefix < alerts[j].Prefix })
+	return alerts
+}
+
+// Sends the violations of the last minute as alerts, every minute
+func reportLintViolations() {
+	if policy == nil || !policy.Notify {
+		return
+	}
+	l := log.New(os.Stdout, "lint	", myLogFormat)
+	lintAlerts.Lock()
+	if C.spikePrefixDepth > 0 {
+		lintAlerts.depth = C.spikePrefixDepth
+	}
+	lintAlerts.Unlock()
+
+	for now := range time.NewTicker(time.Minute).C {
+		for _, a := range lintAlerts.tick(now) {
+			l.Printf("ALERT for prefix '%v': %v", a.Prefix, a.Reason)
+			sendAlert(a)
+		}
+	}
+}
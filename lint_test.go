@@ -0,0 +1,121 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLintRules(t *testing.T) {
+	file, _ := ioutil.TempFile("", "graphite-news-lint")
+	defer os.Remove(file.Name())
+	file.WriteString(`{"Rules": [
+		{"Type": "lowercase"},
+		{"Type": "nospaces"},
+		{"Name": "environment", "Type": "prefix", "Values": ["prod", "dev"], "Severity": "hard"},
+		{"Type": "maxdepth", "Max": 4},
+		{"Type": "suffix", "Values": ["count", "p99"], "Filters": ["prod.app"]},
+		{"Type": "regex", "Pattern": "^[a-z0-9._ -]+$"}
+	]}`)
+	file.Close()
+
+	p, err := loadLintPolicy(file.Name())
+	if err != nil {
+		t.Fatal(fmt.Sprintf("Could not load naming rules: %v", err))
+	}
+
+	var testCases = map[string][]string{
+		"prod.app.checkout.count":    nil,
+		"dev.collectd.cpu.idle":      nil,
+		"prod.app.checkout.latency":  {"suffix"},
+		"Prod.app.Checkout.count":    {"lowercase", "environment", "regex"},
+		"prod.app.a b.count":         {"nospaces"},
+		"staging.db.a.b.c":           {"environment", "maxdepth"},
+		"dev.collectd.cpu.idle.user": {"maxdepth"},
+	}
+	for name, expected := range testCases {
+		violations := p.check(name)
+		var rules []string
+		for _, v := range violations {
+			rules = append(rules, v.Rule)
+		}
+		if fmt.Sprint(rules) != fmt.Sprint(expected) {
+			t.Fatal(fmt.Sprintf("Expected %v to break %v, got %+v", name, expected, violations))
+		}
+	}
+
+	for _, rules := range []string{`{"Rules": [{"Type": "bogus"}]}`, `{"Rules": [{"Type": "maxdepth"}]}`, `{"Rules": [{"Type": "regex", "Pattern": "("}]}`} {
+		ioutil.WriteFile(file.Name(), []byte(rules), 0644)
+		if _, err := loadLintPolicy(file.Name()); err == nil {
+			t.Fatal(fmt.Sprintf("Invalid naming rules were accepted: %v", rules))
+		}
+	}
+}
+
+func TestLintAutoDelete(t *testing.T) {
+	State.Vals = nil // start fresh
+	defer func() { State.Vals = nil }()
+	policy = &lintPolicy{Rules: []lintRule{{Name: "environment", Type: "prefix", Values: []string{"prod"}, Severity: "hard"}, {Name: "lowercase", Type: "lowercase", Severity: "warn"}}, AutoDelete: true}
+	defer func() { policy = nil }()
+	saved := autoDeleted
+	autoDeleted = &deletedNames{&sync.Mutex{}, map[string]time.Time{}}
+	defer func() { autoDeleted = saved }()
+
+	file, _ := ioutil.TempFile("", "graphite-news-lint-wsp")
+	file.Close()
+	defer os.Remove(file.Name())
+
+	addItemToState(Datasource{Name: "prod.app.Count"})
+	addItemToState(Datasource{Name: "test.app.count", filename: file.Name()})
+
+	if ds := getDSbyName("prod.app.Count"); len(ds.Violations) != 1 || ds.Violations[0].Rule != "lowercase" {
+		t.Fatal(fmt.Sprintf("Violations were not attached to data source: %+v", ds))
+	}
+	if len(getDSbyName("test.app.count").Name) > 0 {
+		t.Fatal("Data source with a hard violation was not removed")
+	}
+	if _, err := os.Stat(file.Name()); !os.IsNotExist(err) {
+		t.Fatal("Whisper file of data source with a hard violation was not deleted")
+	}
+
+	// carbon recreates it on the next point, that one is left alone
+	ioutil.WriteFile(file.Name(), nil, 0644)
+	addItemToState(Datasource{Name: "test.app.count", filename: file.Name()})
+	if _, err := os.Stat(file.Name()); err != nil {
+		t.Fatal("Whisper file of a recreated data source was deleted again")
+	}
+	if ds := getDSbyName("test.app.count"); len(ds.Violations) != 1 {
+		t.Fatal(fmt.Sprintf("Recreated data source should be listed with its violations: %+v", ds))
+	}
+}
+
+func TestLintAlerts(t *testing.T) {
+	r := newLintReport(2)
+	now := time.Now()
+	for i := 0; i < 1000; i++ {
+		r.add(fmt.Sprintf("app.Requests.%v", i), "name is not all lowercase (lowercase)")
+	}
+	r.add("test.app.count", "name does not start with one of [prod] (environment)")
+
+	alerts := r.tick(now)
+	if len(alerts) != 2 || alerts[0].Prefix != "app.Requests" || alerts[0].Count != 1000 || alerts[1].Prefix != "test.app" {
+		t.Fatal(fmt.Sprintf("Expected one alert per prefix, got %+v", alerts))
+	}
+	if !strings.Contains(alerts[0].Reason, "app.Requests.0: name is not all lowercase") {
+		t.Fatal(fmt.Sprintf("Expected an example in the alert, got %v", alerts[0].Reason))
+	}
+
+	// same again within the cooldown stays quiet, after it alerts again
+	r.add("app.Requests.x", "name is not all lowercase (lowercase)")
+	if alerts := r.tick(now.Add(time.Minute)); len(alerts) != 0 {
+		t.Fatal(fmt.Sprintf("Alerted again within the cooldown: %+v", alerts))
+	}
+	r.add("app.Requests.y", "name is not all lowercase (lowercase)")
+	if alerts := r.tick(now.Add(alertCooldown + time.Minute)); len(alerts) != 1 || alerts[0].Count != 1 {
+		t.Fatal(fmt.Sprintf("Expected an alert after the cooldown, got %+v", alerts))
+	}
+}
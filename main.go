@@ -30,10 +30,33 @@ type (
 	// Structure of a single data source. Anything in capitals will
 	// get marshalled over to any connecting browser
 	Datasource struct {
-		Name        string    // bla.te.jfwoiejf.1MinuteRate, etc
-		Create_date time.Time // Holds timestamp of when DS got created
-		Params      string    // Holds things like retention schema's, etc
-		filename    string    // /opt/graphite/whisper/etc
+		Name        string      // bla.te.jfwoiejf.1MinuteRate, etc
+		Create_date time.Time   // Holds timestamp of when DS got created
+		Params      string      // Holds things like retention schema's, etc
+		Origin                  // Where we learned about this data source
+		Upstream    string      // graphite-news instance we got it from, if federated
+		IdPattern   string      // Name with IDs in it replaced, f.ex. app.<uuid>.count
+		Violations  []Violation // Naming rules this data source breaks
+		Backend     string      // graphite-web to render it from, see backends.go
+		GrafanaURL  string      `json:",omitempty"` // link to it in Grafana's Explore
+		filename    string      // /opt/graphite/whisper/etc
+	}
+
+	// Describes where a data source was spotted: which logfile (-l), which
+	// carbon instance wrote that logfile and on which host. Gets flattened
+	// into the Datasource when marshalled.
+	Origin struct {
+		Logfile  string // /opt/graphite/storage/log/carbon-cache/carbon-cache-a/creates.log
+		Instance string // carbon-cache-a, etc
+		Host     string // hostname of the machine carbon runs on
+	}
+
+	// Filters that can be applied on the data sources, f.ex. through the
+	// query string of /json/?instance=carbon-cache-a. Empty fields match all.
+	dsFilter struct {
+		Origin
+		Upstream string
+		Prefix   string // graphite style glob, see matchesPrefix
 	}
 
 	// Holds the state (all newly detected data sources)
@@ -56,12 +79,73 @@ type (
 		// random ones, only the ones in the State (e.g. the last maxState # of items)
 		AllowDsDeletes bool
 
+		// If set, the UI graphs data sources from their whisper files
+		// through /data/, instead of through graphite-web
+		LocalRender bool
+
 		// These are used for reporting Graphite-news' own
 		// stats to a Graphite server. If that server is being monitored
 		// by Graphite-news, you've recreated Inception!
 		reporterGraphiteEnabled bool
 		reporterGraphiteHost    string
 		reporterGraphitePrep    string
+
+		// Addresses to receive syslog messages on (udp://:514, etc)
+		syslogAddrs loglocslice
+
+		// systemd units to follow in the journal, and where to keep cursors
+		journalUnits     loglocslice
+		journalCursorDir string
+
+		// Accept create events from other hosts: raw carbon log lines over
+		// TCP/UDP on ingestAddr, and JSON POSTs to /ingest/ if AllowIngest
+		ingestAddr  string
+		AllowIngest bool
+
+		// Other graphite-news instances to poll and merge into our State
+		upstreams loglocslice
+
+		// JSON file with webhook endpoints to notify of new data sources
+		webhookFile string
+
+		// JSON file with Slack style chat webhooks to post digests to
+		chatFile string
+
+		// JSON file with the SMTP settings and schedule for email digests
+		emailFile string
+
+		// When to alert on metric explosions, see spikes.go
+		spikeThreshold       int
+		spikePrefixThreshold int
+		spikePrefixDepth     int
+		spikeSigma           float64
+
+		// JSON file with the naming rules to check new data sources against
+		lintFile string
+
+		// Proxy /render/ to graphite-web, see render.go. ProxyRender tells
+		// the UI to load its graphs from us.
+		ProxyRender     bool
+		proxyAuthHeader string
+		proxyCacheSize  int
+		proxyTimeout    time.Duration
+
+		// JSON file with more graphite-webs, see backends.go. Backends holds
+		// the URL of every backend by name, for the UI.
+		backendFile string
+		Backends    map[string]string
+
+		// Grafana to link data sources to, and the UID of its Graphite
+		// data source, see grafana.go
+		grafanaURL string
+		grafanaUID string
+
+		// Record new data sources as Graphite events, batched per window
+		graphiteEvents bool
+		eventWindow    time.Duration
+
+		// File to keep the create history in across restarts, see trends.go
+		historyFile string
 	}
 
 	// used for parsing Flags input params
@@ -114,45 +198,67 @@ func init() {
 	flag.StringVar(&C.GraphiteURL, "s", "http://localhost:8080", "URL of the Graphite render API, no trailing slash. Apple rendezvous domains do not work (like http://machine.local, use IPs in that case)")
 	flag.Var(&C.logfileLocation, "l", "One or more locations of the Carbon logfiles we need to tail. (F.ex. -l file1 -l file2 -l *.log)")
 	flag.BoolVar(&C.AllowDsDeletes, "d", false, "If set, allow clients to delete recently created data sources")
+	flag.BoolVar(&C.LocalRender, "lg", false, "If set, draw graphs in the UI from the whisper files instead of through graphite-web")
 	flag.BoolVar(&C.reporterGraphiteEnabled, "r", false, "If set, report our own statistics every minute to a graphite host")
 	flag.StringVar(&C.reporterGraphiteHost, "rh", "localhost:2003", "Change the graphite host for pushing metrics towards")
 	flag.StringVar(&C.reporterGraphitePrep, "rp", "graphite-news.metrics", "Prepend all metric names with this string")
+	flag.StringVar(&C.ingestAddr, "n", "", "If set, listen on this address (f.ex. :2935) for raw carbon log lines over TCP and UDP")
+	flag.BoolVar(&C.AllowIngest, "ni", false, "If set, accept new data sources POSTed as JSON to /ingest/")
+	flag.Var(&C.journalUnits, "ju", "One or more systemd units to follow in the journal. (F.ex. -ju carbon-cache@a.service)")
+	flag.StringVar(&C.journalCursorDir, "jc", "", "If set, directory to remember the journal position in, so restarts continue where they left off")
+	flag.Var(&C.upstreams, "u", "One or more graphite-news instances to pull data sources from. (F.ex. -u http://carbon1:2934 -u http://carbon2:2934)")
+	flag.StringVar(&C.webhookFile, "wh", "", "If set, JSON file with webhooks to POST new data sources to")
+	flag.StringVar(&C.chatFile, "cw", "", "If set, JSON file with Slack/Mattermost webhooks to post digests of new data sources to")
+	flag.StringVar(&C.emailFile, "em", "", "If set, JSON file with SMTP settings and schedule for mailing digests of new data sources")
+	flag.IntVar(&C.spikeThreshold, "xt", 0, "Alert when more than this many data sources get created in a minute (0 disables)")
+	flag.IntVar(&C.spikePrefixThreshold, "xp", 0, "Alert when more than this many data sources get created under a single prefix in a minute (0 disables)")
+	flag.IntVar(&C.spikePrefixDepth, "xd", 2, "Number of name segments that make up a prefix when counting creates")
+	flag.Float64Var(&C.spikeSigma, "xs", 0, "Alert when creates per minute are this many standard deviations above normal (0 disables)")
+	flag.StringVar(&C.lintFile, "lr", "", "If set, JSON file with naming rules to check new data sources against")
+	flag.BoolVar(&C.ProxyRender, "px", false, "If set, proxy /render/ to graphite-web so browsers only need to reach graphite-news")
+	flag.StringVar(&C.proxyAuthHeader, "pa", "", "Header to add to proxied render requests (F.ex. -pa 'Authorization: Basic dXNlcjpwYXNz')")
+	flag.IntVar(&C.proxyCacheSize, "pc", 200, "Number of proxied render responses to cache")
+	flag.DurationVar(&C.proxyTimeout, "pt", 30*time.Second, "Timeout of proxied render requests")
+	flag.StringVar(&C.backendFile, "gb", "", "If set, JSON file with more Graphite render APIs and which data sources they have")
+	flag.StringVar(&C.grafanaURL, "gf", "", "If set, URL of Grafana to link new data sources to, no trailing slash")
+	flag.StringVar(&C.grafanaUID, "gu", "", "UID of the Graphite data source in Grafana, for the links of -gf")
+	flag.BoolVar(&C.graphiteEvents, "ev", false, "If set, record new data sources as events in Graphite")
+	flag.DurationVar(&C.eventWindow, "ew", time.Minute, "Record all data sources found within this period as one event per prefix")
+	flag.StringVar(&C.historyFile, "hf", "", "If set, file to keep the create history in, so trends and the tree survive restarts")
+	flag.Var(&C.syslogAddrs, "sl", "One or more addresses to receive syslog messages on. (F.ex. -sl udp://:514 -sl tcp://:514 -sl unix:///dev/log)")
 
 	flag.Usage = func() {
-		fmt.Printf("Usage: graphite-news [-i sec] [-p port] [-s graphite url] [-r] [-d] -l logfile \n")
+		fmt.Printf("Usage: graphite-news [-i sec] [-p port] [-s graphite url] [-gb file] [-gf grafana url] [-r] [-d] [-lg] [-px] [-pa header] [-n addr] [-ni] [-sl addr] [-ju unit] [-u upstream] [-wh file] [-cw file] [-em file] [-ev] [-hf file] [-xt n] [-xp n] [-lr file] -l logfile \n")
 		fmt.Printf("Version: %v (Compiled at %v). Code over at: https://github.com/ojilles/graphite-news/\n\n", VERSION, BUILD_DATE)
 		flag.PrintDefaults()
 	}
 }
 
-func makeHandler(fn func(http.ResponseWriter, *http.Request)) http.HandlerFunc {
+// Times requests to the route a handler is registered on, see httpmetrics.go
+func makeHandler(route string, fn func(http.ResponseWriter, *http.Request)) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		// For metric names, escape any natural dots in the name,
-		// then replace any URL slashes with a dot (seems same semantics to me)
-		// and make sure we dont have any starting or ending with a dot.
-		metricUrl := strings.Replace(r.URL.Path, ".", "_", -1)
-		metricUrl = strings.Replace(metricUrl, "/", ".", -1)
-		metricUrl = strings.Trim(metricUrl, ".")
-		if len(metricUrl) == 0 {
-			metricUrl = "index_html"
-		}
-
-		metricName := fmt.Sprintf("%s.%s", r.Method, metricUrl)
-		aggMetricName := fmt.Sprintf("%s.__all_reqs", r.Method)
+		metricName := trackedRoutes.track(r.Method, route)
+		aggMetricName := fmt.Sprintf("%s.__all_reqs", metricMethod(r.Method))
 		m := metrics.GetOrRegisterTimer(metricName, metrics.DefaultRegistry)
 		mGet := metrics.GetOrRegisterTimer(aggMetricName, metrics.DefaultRegistry)
+		sw := &statusWriter{ResponseWriter: w}
 		mGet.Time(func() {
 			m.Time(func() {
-				fn(w, r)
+				fn(sw, r)
 			})
 		})
+		metrics.GetOrRegisterCounter(fmt.Sprintf("responses.%s.%d", metricName, sw.code()), metrics.DefaultRegistry).Inc(1)
 	}
 }
 
 func jsonHandler(w http.ResponseWriter, r *http.Request) {
-	State.RLock() // grab a lock, but then don't forget to
-	js, err := json.Marshal(State.Vals)
-	State.RUnlock() // unlock it again once we're done
+	if format := exportFormat(r); len(format) > 0 {
+		if !writeExport(w, r, format) {
+			http.Error(w, "format should be csv or ndjson", http.StatusBadRequest)
+		}
+		return
+	}
+	js, err := json.Marshal(getFilteredDSs(newFilter(r)))
 
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -216,21 +322,31 @@ func deleteHandler(w http.ResponseWriter, r *http.Request) {
 	r.ParseForm()
 	dsName := r.PostFormValue("datasourcename")
 	ds := getDSbyName(dsName)
-	Success := false
-
-	if (len(ds.Name) > 0) && (len(ds.filename) > 0) {
-		Success = deleteFile(ds.filename)
-	}
+	Success := deleteDatasource(ds)
 
 	if Success == true {
-		_ = deleteDSbyName(dsName)
 		w.Write(nil)
 	} else {
 		http.Error(w, "", http.StatusInternalServerError)
 	}
 
-	l.Printf("DELETE called for '%v' (filename: %v) with result: '%v'",
-		dsName, ds.filename, Success)
+	l.Printf("DELETE called for '%v' (filename: %v, upstream: %v) with result: '%v'",
+		dsName, ds.filename, ds.Upstream, Success)
+}
+
+// Deletes the whisper file of a data source, or has its upstream do so,
+// and removes it from the State. Returns true if that worked.
+func deleteDatasource(ds Datasource) bool {
+	Success := false
+	if (len(ds.Name) > 0) && (len(ds.Upstream) > 0) {
+		Success = forwardDelete(ds)
+	} else if (len(ds.Name) > 0) && (len(ds.filename) > 0) {
+		Success = deleteFile(ds.filename)
+	}
+	if Success {
+		_ = deleteDSbyName(ds.Name)
+	}
+	return Success
 }
 
 func frontpageHandler(w http.ResponseWriter, r *http.Request) {
@@ -304,6 +420,13 @@ func addItemToState(ds Datasource) {
 	State.RUnlock()
 
 	if !foundDuplicate {
+		ds.IdPattern, _, _ = idPattern(ds.Name)
+		ds.Backend = backendFor(ds)
+		ds.GrafanaURL = grafanaLink(ds)
+		if !lintDatasource(&ds) {
+			return
+		}
+
 		// We're writing to shared datastructures, grab a Write-lock
 		State.Lock()
 		defer State.Unlock()
@@ -315,22 +438,77 @@ func addItemToState(ds Datasource) {
 
 		l.Printf("New datasource: %+v (total: %v)", ds.Name, len(State.Vals))
 		defer m_ds.Inc(1)
+
+		// Let anyone interested (webhooks, etc) know, this never blocks
+		announce(ds)
 	}
 }
 
-func parseLine(line string) {
+func parseLine(line string, o Origin) {
 	m_lines := metrics.GetOrRegisterCounter("tail.input_lines", metrics.DefaultRegistry)
-	var dataPath = regexp.MustCompile(`[a-zA-Z\:]*([0-9].*) ::( \[creates\])? creating database file (.*/whisper/(.*)\.wsp) (.*)`)
+	// The timestamp is optional, as syslog messages do not have one
+	var dataPath = regexp.MustCompile(`(?:[a-zA-Z\:]*([0-9].*) ::)?( ?\[creates\])? ?creating database file (.*/whisper/(.*)\.wsp) (.*)`)
 	m_lines.Inc(1)
 	match := dataPath.FindStringSubmatch(line)
 	if len(match) > 0 {
 		ds := fmt.Sprintf("%s", strings.Replace(match[4], `/`, `.`, -1))
-		tmp := Datasource{Name: ds, Create_date: parseTime(match[1]), Params: match[5], filename: match[3]}
+		tmp := Datasource{Name: ds, Create_date: parseTime(match[1]), Params: match[5], Origin: o, filename: match[3]}
+		if tmp.Create_date.IsZero() {
+			tmp.Create_date = time.Now()
+		}
 		addItemToState(tmp)
 	}
 
 }
 
+// Builds a filter out of the query string parameters of a request
+func newFilter(r *http.Request) dsFilter {
+	q := r.URL.Query()
+	return dsFilter{
+		Origin: Origin{
+			Logfile:  q.Get("logfile"),
+			Instance: q.Get("instance"),
+			Host:     q.Get("host"),
+		},
+		Upstream: q.Get("upstream"),
+		Prefix:   q.Get("prefix"),
+	}
+}
+
+// Returns true if the data source passes all filter criteria
+func (f dsFilter) match(ds Datasource) bool {
+	if len(f.Logfile) > 0 && f.Logfile != ds.Logfile {
+		return false
+	}
+	if len(f.Instance) > 0 && f.Instance != ds.Instance {
+		return false
+	}
+	if len(f.Host) > 0 && f.Host != ds.Host {
+		return false
+	}
+	if len(f.Upstream) > 0 && f.Upstream != ds.Upstream {
+		return false
+	}
+	if len(f.Prefix) > 0 && !matchesPrefix(f.Prefix, ds.Name) {
+		return false
+	}
+	return true
+}
+
+// Returns a copy of all data sources in the State that match the filter
+func getFilteredDSs(f dsFilter) []Datasource {
+	State.RLock()
+	defer State.RUnlock()
+
+	result := []Datasource{}
+	for _, ds_tmp := range State.Vals {
+		if f.match(ds_tmp) {
+			result = append(result, ds_tmp)
+		}
+	}
+	return result
+}
+
 func deleteDSbyName(dsName string) bool {
 	if len(getDSbyName(dsName).Name) == 0 {
 		return false
@@ -361,14 +539,33 @@ func getDSbyName(dsName string) Datasource {
 	return x
 }
 
+// Carbon daemons are typically named like carbon-cache-a, carbon-relay, etc.
+var carbonInstance = regexp.MustCompile(`^carbon-(cache|relay|aggregator)(-[\w]+)?$`)
+
+// Figures out which carbon instance wrote a logfile, based on its path
+// (e.g. /opt/graphite/storage/log/carbon-cache/carbon-cache-a/creates.log
+// is carbon-cache-a). Falls back to the basename of the logfile.
+func instanceFromPath(file string) string {
+	dirs := strings.Split(filepath.ToSlash(filepath.Dir(file)), "/")
+	for i := len(dirs) - 1; i >= 0; i-- {
+		if carbonInstance.MatchString(dirs[i]) {
+			return dirs[i]
+		}
+	}
+	base := filepath.Base(file)
+	return strings.TrimSuffix(base, filepath.Ext(base))
+}
+
 func tailLogfile(c chan string, file string) {
 	l := log.New(os.Stdout, "main	", myLogFormat)
 	tc := tail.Config{Follow: true, ReOpen: true, MustExist: true}
+	host, _ := os.Hostname()
+	o := Origin{Logfile: file, Instance: instanceFromPath(file), Host: host}
 	t, err := tail.TailFile(file, tc)
 	if err == nil {
-		l.Print(fmt.Sprintf("Tailing File:[%s]\n", file))
+		l.Print(fmt.Sprintf("Tailing File:[%s] (instance: %s)\n", file, o.Instance))
 		for line := range t.Lines {
-			parseLine(line.Text)
+			parseLine(line.Text, o)
 		}
 	}
 	c <- fmt.Sprintf("%s", err)
@@ -419,17 +616,65 @@ func main() {
 		C.logfileLocation = AppendIfMissing(C.logfileLocation, argument)
 	}
 
+	// Naming rules need to be in place before the first data source comes in
+	if len(C.lintFile) > 0 {
+		var err error
+		if policy, err = loadLintPolicy(C.lintFile); err != nil {
+			l.Fatal(err)
+		}
+	}
+
+	if len(C.proxyAuthHeader) > 0 {
+		if _, _, err := parseHeader(C.proxyAuthHeader); err != nil {
+			l.Fatal(err)
+		}
+	}
+	renders.max = C.proxyCacheSize
+
+	// Backends need to be known before the first data source gets routed
+	if len(C.backendFile) > 0 {
+		var err error
+		if backends, err = loadBackends(C.backendFile); err != nil {
+			l.Fatal(err)
+		}
+	}
+	C.Backends = backendURLs()
+
+	// The history needs to be back before recordHistory compacts the file
+	if len(C.historyFile) > 0 {
+		if err := loadHistory(C.historyFile); err != nil {
+			l.Fatal(err)
+		}
+	}
+
 	// Set up web handlers in goroutines
 	mux := http.NewServeMux()
-	mux.HandleFunc("/json/", makeHandler(jsonHandler))
-	mux.HandleFunc("/stats/", makeHandler(statsHandler))
-	mux.HandleFunc("/config/", makeHandler(configHandler))
-	mux.HandleFunc("/delete/", makeHandler(deleteHandler))
+	handle := func(route string, fn func(http.ResponseWriter, *http.Request)) {
+		mux.HandleFunc(route, makeHandler(route, fn))
+	}
+	handle("/json/", jsonHandler)
+	handle("/stats/", statsHandler)
+	handle("/config/", configHandler)
+	handle("/delete/", deleteHandler)
+	handle("/ingest/", ingestHandler)
+	handle("/webhooks/", webhooksHandler)
+	handle("/cardinality/", cardinalityHandler)
+	handle("/tree/", treeHandler)
+	handle("/trends/", trendsHandler)
+	handle("/feed.atom", atomHandler)
+	handle("/feed.rss", rssHandler)
+	handle(apiPrefix, apiHandler)
+	handle("/datasource/", datasourceHandler)
+	handle("/data/", dataHandler)
+	handle("/spark/", sparkHandler)
+	handle("/render/", renderHandler)
+	handle("/grafana/", grafanaHandler)
+	handle("/metrics", prometheusHandler)
 
 	// These are all handled by the compiled in Assets
-	mux.HandleFunc("/", makeHandler(frontpageHandler))
-	mux.HandleFunc("/favicon.ico", makeHandler(faviconHandler))
-	mux.HandleFunc(staticAssetsURL, makeHandler(staticHandler))
+	handle("/", frontpageHandler)
+	handle("/favicon.ico", faviconHandler)
+	handle(staticAssetsURL, staticHandler)
 
 	// Add the logging handler for Apache Common-ish log output
 	loggingHandler := apachelog.NewHandler(mux, os.Stdout)
@@ -440,6 +685,18 @@ func main() {
 
 	go server.ListenAndServe()
 	go tailLogfiles(error_channel)
+	go listenForLines(error_channel)
+	go listenSyslog(error_channel)
+	go followJournals(error_channel)
+	go pollUpstreams()
+	go startWebhooks(error_channel)
+	go startChat(error_channel)
+	go startEmail(error_channel)
+	go detectSpikes()
+	go reportLintViolations()
+	go trackCardinality()
+	go recordHistory()
+	go recordEvents()
 	go reportMetrics()
 
 	l.Println("Graphite News -- Showing which new metrics are available since 2014")
@@ -447,8 +704,21 @@ func main() {
 	l.Println(fmt.Sprintf("Graphite News -- http://localhost:%v		:: Main User Interface", C.ServerPort))
 	l.Println(fmt.Sprintf("Graphite News -- http://localhost:%v/config/	:: Internal configuration in JSON", C.ServerPort))
 	l.Println(fmt.Sprintf("Graphite News -- http://localhost:%v/stats/	:: Internal Metrics in JSON", C.ServerPort))
+	l.Println(fmt.Sprintf("Graphite News -- http://localhost:%v/metrics	:: Internal Metrics for Prometheus", C.ServerPort))
 	l.Println(fmt.Sprintf("Graphite News -- http://localhost:%v/json/	:: JSON dump of new graphite data sources", C.ServerPort))
-	l.Println(fmt.Sprintf("Configuration: %+v", C))
+	l.Println(fmt.Sprintf("Graphite News -- http://localhost:%v/cardinality/	:: Data source names with IDs in them", C.ServerPort))
+	l.Println(fmt.Sprintf("Graphite News -- http://localhost:%v/tree/	:: New data sources rolled up on prefix", C.ServerPort))
+	l.Println(fmt.Sprintf("Graphite News -- http://localhost:%v/trends/	:: Creates over time, per prefix and host", C.ServerPort))
+	l.Println(fmt.Sprintf("Graphite News -- http://localhost:%v/feed.atom	:: Atom feed of new data sources (also /feed.rss)", C.ServerPort))
+	l.Println(fmt.Sprintf("Graphite News -- http://localhost:%v/api/v1/openapi.json	:: REST API description", C.ServerPort))
+	l.Println(fmt.Sprintf("Graphite News -- http://localhost:%v/datasource/{name}	:: A single data source with live whisper info", C.ServerPort))
+	l.Println(fmt.Sprintf("Graphite News -- http://localhost:%v/data/{name}	:: Points of a data source from its whisper file", C.ServerPort))
+	l.Println(fmt.Sprintf("Graphite News -- http://localhost:%v/spark/{name}.svg	:: Sparkline of a data source", C.ServerPort))
+	shown := C
+	if len(shown.proxyAuthHeader) > 0 {
+		shown.proxyAuthHeader = "(hidden)" // don't log credentials
+	}
+	l.Println(fmt.Sprintf("Configuration: %+v", shown))
 	// Wait for errors to appear then shut down
 	l.Println(<-error_channel)
 }
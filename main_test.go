@@ -155,12 +155,13 @@ func TestParsing(t *testing.T) {
 		{0, "launchctl-carbon.stdout:24/08/2014 23:10:40 :: [creates] creating database file /opt/graphite/storage/whisper/mac-mini_local/collectd/curl_xml-default/.wsp (archive=[(60, 525600), (600, 518400)] xff=None agg=None)"},
 		{1, "launchctl-carbon.stdout:24/08/2014 23:10:40 :: creating database file /opt/graphite/storage/whisper/mac-mini_local/collectd/curl_xml-default/gauge-tvseries_watched-Babylon_6.wsp (archive=[(60, 525600), (600, 518400)] xff=None agg=None)"},
 		{1, "30/09/2014 00:04:17 :: creating database file /opt/graphite/storage/whisper/graphite-news/metrics/POST123/delete/999-percentile.wsp (archive=[(60, 525600), (600, 518400)] xff=None agg=None)"},
+		{1, "[creates] creating database file /opt/graphite/storage/whisper/local/syslog/no-timestamp.wsp (archive=[(60, 525600), (600, 518400)] xff=None agg=None)"},
 	}
 	State.Vals = nil // start fresh
 	prev_count := len(State.Vals)
 
 	for _, test := range testCases {
-		parseLine(test.line)
+		parseLine(test.line, Origin{})
 
 		if len(State.Vals) != prev_count+test.incr {
 			t.Fatal(fmt.Sprintf("Parsed line, should have seen %v new entries, saw %v. Line: %v", test.incr, len(State.Vals)-prev_count, test))
@@ -178,3 +179,36 @@ func TestParsing(t *testing.T) {
 		}
 	}
 }
+
+func TestInstanceFromPath(t *testing.T) {
+	var testCases = map[string]string{
+		"/opt/graphite/storage/log/carbon-cache/carbon-cache-a/creates.log": "carbon-cache-a",
+		"/opt/graphite/storage/log/carbon-cache/carbon-cache-b/creates.log": "carbon-cache-b",
+		"/var/log/carbon-relay/creates.log":                                 "carbon-relay",
+		"/opt/graphite/log/launchctl-carbon.stdout":                         "launchctl-carbon",
+	}
+	for file, expected := range testCases {
+		if instance := instanceFromPath(file); instance != expected {
+			t.Fatal(fmt.Sprintf("Expected instance [%v] for [%v], but got [%v]", expected, file, instance))
+		}
+	}
+}
+
+func TestFilterOnOrigin(t *testing.T) {
+	State.Vals = nil // start fresh
+	defer func() { State.Vals = nil }()
+
+	line := "13/09/2014 23:10:56 :: [creates] creating database file /opt/graphite/storage/whisper/local/random/%v.wsp (archive=[(60, 525600)] xff=None agg=None)"
+	parseLine(fmt.Sprintf(line, "a"), Origin{Logfile: "a.log", Instance: "carbon-cache-a", Host: "host1"})
+	parseLine(fmt.Sprintf(line, "b"), Origin{Logfile: "b.log", Instance: "carbon-cache-b", Host: "host1"})
+
+	if ds := getDSbyName("local.random.a"); ds.Instance != "carbon-cache-a" || ds.Logfile != "a.log" || ds.Host != "host1" {
+		t.Fatal(fmt.Sprintf("Origin was not recorded on data source: %+v", ds))
+	}
+	if found := getFilteredDSs(dsFilter{Origin: Origin{Instance: "carbon-cache-b"}}); len(found) != 1 || found[0].Name != "local.random.b" {
+		t.Fatal(fmt.Sprintf("Filtering on instance returned wrong data sources: %+v", found))
+	}
+	if found := getFilteredDSs(dsFilter{Origin: Origin{Host: "host1"}}); len(found) != 2 {
+		t.Fatal(fmt.Sprintf("Filtering on host should have returned 2 data sources: %+v", found))
+	}
+}
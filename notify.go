@@ -0,0 +1,289 @@
+package main
+
+// Plumbing shared by everything that wants to hear about new data sources
+// (webhooks, chat, etc). addItemToState announces every data source it
+// accepts, subscribers get them on a buffered channel. A subscriber that
+// can't keep up loses data sources instead of holding up the tailing.
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/rcrowley/go-metrics"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+type (
+	// Holds the channels of everyone listening for new data sources
+	subscriberList struct {
+		*sync.RWMutex
+		chans []chan Datasource
+	}
+
+	// Webhooks and chat routes that alerts get sent to as well
+	alertTargetList struct {
+		*sync.RWMutex
+		webhooks []webhook
+		chat     []chatRoute
+	}
+
+	// What we tell the world when something is off, f.ex. a metric
+	// explosion (Kind spike) or a badly named data source (Kind lint)
+	alert struct {
+		Time      time.Time
+		Kind      string
+		Prefix    string  // empty for all data sources
+		Name      string  `json:",omitempty"` // data source, if about a single one
+		Count     int     // creates in the last minute
+		Threshold int     // fixed threshold that was crossed, if any
+		Baseline  float64 // average creates per minute
+		Reason    string
+	}
+
+	// A group of data sources sharing the same prefix
+	dsGroup struct {
+		Prefix  string
+		Names   []string
+		Backend string // of the first data source in the group
+	}
+)
+
+const (
+	// Number of data sources a subscriber can lag behind before dropping some
+	subscriberBuffer = 1000
+
+	// Number of data sources to collect for a single flush of batchDatasources
+	maxBatch = 10000
+)
+
+var (
+	subscribers  = &subscriberList{&sync.RWMutex{}, nil}
+	alertTargets = &alertTargetList{&sync.RWMutex{}, nil, nil}
+)
+
+// Returns a channel on which every new data source will be delivered
+func subscribe() chan Datasource {
+	c := make(chan Datasource, subscriberBuffer)
+	subscribers.Lock()
+	defer subscribers.Unlock()
+	subscribers.chans = append(subscribers.chans, c)
+	return c
+}
+
+// Stops delivering to a channel from subscribe, and closes it
+func unsubscribe(c chan Datasource) {
+	subscribers.Lock()
+	defer subscribers.Unlock()
+	for i, sub := range subscribers.chans {
+		if sub == c {
+			subscribers.chans = append(subscribers.chans[:i:i], subscribers.chans[i+1:]...)
+			close(c)
+			return
+		}
+	}
+}
+
+// Hands a new data source to all subscribers, without ever blocking
+func announce(ds Datasource) {
+	m := metrics.GetOrRegisterCounter("notify.dropped", metrics.DefaultRegistry)
+	subscribers.RLock()
+	defer subscribers.RUnlock()
+
+	for _, c := range subscribers.chans {
+		select {
+		case c <- ds:
+		default:
+			m.Inc(1)
+		}
+	}
+}
+
+func setAlertWebhooks(hooks []webhook) {
+	alertTargets.Lock()
+	defer alertTargets.Unlock()
+	alertTargets.webhooks = hooks
+}
+
+func setAlertChatRoutes(routes []chatRoute) {
+	alertTargets.Lock()
+	defer alertTargets.Unlock()
+	alertTargets.chat = routes
+}
+
+func getAlertTargets() ([]webhook, []chatRoute) {
+	alertTargets.RLock()
+	defer alertTargets.RUnlock()
+	return alertTargets.webhooks, alertTargets.chat
+}
+
+// Collects data sources from a channel for the duration of window (from
+// the first one that comes in), then hands them all to flush in one go.
+// A window of 0 flushes every data source on its own. Flushes are done one
+// at a time and in order: while one is busy (f.ex. retrying a webhook),
+// new data sources pile up in the next batch, up to maxBatch. Returns once
+// in is closed and everything in it has been flushed.
+func batchDatasources(in chan Datasource, window time.Duration, flush func([]Datasource)) {
+	m := metrics.GetOrRegisterCounter("notify.dropped", metrics.DefaultRegistry)
+	queue := make(chan []Datasource)
+	done := make(chan bool)
+	go func() {
+		for batch := range queue {
+			flush(batch)
+		}
+		close(done)
+	}()
+
+	var batch []Datasource
+	var timer <-chan time.Time
+	ready := false
+	for {
+		// only offer the batch to the flusher once it is ready
+		var out chan []Datasource
+		if ready {
+			out = queue
+		}
+
+		select {
+		case ds, ok := <-in:
+			if !ok {
+				if len(batch) > 0 {
+					queue <- batch
+				}
+				close(queue)
+				<-done
+				return
+			}
+			if len(batch) >= maxBatch {
+				m.Inc(1)
+				continue
+			}
+			batch = append(batch, ds)
+			if window <= 0 {
+				ready = true
+			} else if timer == nil && !ready {
+				timer = time.After(window)
+			}
+		case <-timer:
+			ready = true
+			timer = nil
+		case out <- batch:
+			batch = nil
+			ready = false
+		}
+	}
+}
+
+// Checks a data source name against a glob pattern in graphite style, e.g.
+// a * does not cross dots. The pattern matches the name if it matches the
+// name or any of its parents, so app.payments and app.pay* both match
+// app.payments.latency.
+func matchesPrefix(pattern string, name string) bool {
+	patternParts := strings.Split(pattern, ".")
+	nameParts := strings.Split(name, ".")
+	if len(patternParts) > len(nameParts) {
+		return false
+	}
+	for i, p := range patternParts {
+		if ok, err := path.Match(p, nameParts[i]); !ok || err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns true if any of the patterns match, or if there are no patterns
+func matchesAnyPrefix(patterns []string, name string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, p := range patterns {
+		if matchesPrefix(p, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Groups data sources on the first depth segments of their names, largest
+// groups first.
+func groupByPrefix(dss []Datasource, depth int) []dsGroup {
+	index := map[string]int{}
+	var groups []dsGroup
+
+	for _, ds := range dss {
+		parts := strings.Split(ds.Name, ".")
+		if len(parts) > depth {
+			parts = parts[:depth]
+		}
+		prefix := strings.Join(parts, ".")
+		i, ok := index[prefix]
+		if !ok {
+			i = len(groups)
+			index[prefix] = i
+			groups = append(groups, dsGroup{Prefix: prefix, Backend: ds.Backend})
+		}
+		groups[i].Names = append(groups[i].Names, ds.Name)
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		return len(groups[i].Names) > len(groups[j].Names)
+	})
+	return groups
+}
+
+// Link to a graph in Graphite showing the new data sources of a group. The
+// group prefix is expanded with wildcards to the depth of its first member.
+func groupGraphURL(g dsGroup) string {
+	target := g.Prefix
+	if len(g.Names) > 1 {
+		extra := strings.Count(g.Names[0], ".") - strings.Count(g.Prefix, ".")
+		target += strings.Repeat(".*", extra)
+	} else {
+		target = g.Names[0]
+	}
+	return graphiteURLFor(Datasource{Backend: g.Backend}) + "/?target=" + url.QueryEscape(target)
+}
+
+// Titles of the different kinds of alerts
+var alertTitles = map[string]string{
+	"spike": "Metric explosion",
+	"lint":  "Naming policy violation",
+}
+
+// Sends an alert to every configured webhook and the chat route for its
+// data source or prefix. Alerts about everything go to all chat routes.
+func sendAlert(a alert) {
+	// spikes.alerts predates other kinds of alerts, dashboards use it
+	metrics.GetOrRegisterCounter("alerts."+a.Kind, metrics.DefaultRegistry).Inc(1)
+	if a.Kind == "spike" {
+		metrics.GetOrRegisterCounter("spikes.alerts", metrics.DefaultRegistry).Inc(1)
+	}
+
+	about := a.Name
+	if len(about) == 0 {
+		about = a.Prefix
+	}
+
+	hooks, routes := getAlertTargets()
+	for _, hook := range hooks {
+		if len(about) == 0 || matchesAnyPrefix(hook.Filters, about) {
+			body, _ := json.Marshal(webhookPayload{Count: a.Count, Alert: &a})
+			postWithRetries(hook.URL, body, hook.Retries, a.Count)
+		}
+	}
+
+	text := fmt.Sprintf(":rotating_light: *%s* for `%s`: %s", alertTitles[a.Kind], about, a.Reason)
+	if len(about) == 0 {
+		text = fmt.Sprintf(":rotating_light: *%s* for all data sources: %s", alertTitles[a.Kind], a.Reason)
+	}
+	for i, route := range routes {
+		if len(about) == 0 || i == routeFor(routes, about) {
+			body, _ := json.Marshal(chatMessage{Text: text, Channel: route.Channel, Username: "graphite-news"})
+			postWithRetries(route.URL, body, route.Retries, a.Count)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package main
+
+// Our own metrics on /metrics in the Prometheus text format, for those that
+// scrape instead of having them pushed to Graphite (-r). It is the same
+// go-metrics registry, with the dotted names turned into Prometheus names:
+//
+//	GET.json                                graphite_news_http_request_duration_seconds{method="GET",path="/json/"}
+//	responses.GET.json.404                  graphite_news_http_responses_total{method="GET",path="/json/",status="404"}
+//	tail.input_lines                        graphite_news_tail_input_lines_total
+//	spikes.prefix.app_x.creates_per_minute  graphite_news_spikes_prefix_creates_per_minute{prefix="app.x"}
+//
+// The per method aggregates (GET.__all_reqs) are left out, Prometheus can
+// sum over path itself.
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/rcrowley/go-metrics"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+type (
+	promSample struct {
+		suffix string
+		labels [][2]string
+		value  float64
+	}
+
+	promFamily struct {
+		name    string
+		kind    string
+		help    string
+		samples []promSample
+	}
+)
+
+const promNamespace = "graphite_news"
+
+var (
+	promQuantiles = []float64{0.5, 0.9, 0.99}
+
+	invalidPromChars = regexp.MustCompile("[^a-zA-Z0-9_]")
+)
+
+// Turns a dotted go-metrics name into a valid Prometheus one
+func promName(name string) string {
+	return promNamespace + "_" + invalidPromChars.ReplaceAllString(name, "_")
+}
+
+func promEscape(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
+
+// Method and path of a timer registered by makeHandler, the route it was
+// tracked for if we know it
+func promRoute(name string) (string, string) {
+	if method, route, ok := trackedRoutes.route(name); ok {
+		return method, route
+	}
+	parts := strings.SplitN(name, ".", 2)
+	if len(parts) != 2 {
+		return parts[0], "/"
+	}
+	if parts[1] == "index_html" {
+		return parts[0], "/"
+	}
+	return parts[0], "/" + strings.Replace(parts[1], ".", "/", -1)
+}
+
+// Collects all metrics in the registry as Prometheus metric families
+func promFamilies(r metrics.Registry) []*promFamily {
+	families := map[string]*promFamily{}
+	add := func(name string, kind string, help string, s promSample) {
+		f, ok := families[name]
+		if !ok {
+			f = &promFamily{name: name, kind: kind, help: help}
+			families[name] = f
+		}
+		f.samples = append(f.samples, s)
+	}
+
+	r.Each(func(name string, i interface{}) {
+		switch m := i.(type) {
+		case metrics.Timer:
+			if strings.HasSuffix(name, ".__all_reqs") {
+				return
+			}
+			method, path := promRoute(name)
+			labels := [][2]string{{"method", method}, {"path", path}}
+			t := m.Snapshot()
+			family := promNamespace + "_http_request_duration_seconds"
+			help := "Time spent handling HTTP requests"
+			for i, p := range t.Percentiles(promQuantiles) {
+				add(family, "summary", help, promSample{"", append(labels[:2:2], [2]string{"quantile", fmt.Sprint(promQuantiles[i])}), p / float64(time.Second)})
+			}
+			add(family, "summary", help, promSample{"_sum", labels, float64(t.Sum()) / float64(time.Second)})
+			add(family, "summary", help, promSample{"_count", labels, float64(t.Count())})
+		case metrics.Counter:
+			if strings.HasPrefix(name, "responses.") {
+				i := strings.LastIndex(name, ".")
+				method, path := promRoute(name[len("responses."):i])
+				add(promNamespace+"_http_responses_total", "counter", "HTTP responses per status code",
+					promSample{"", [][2]string{{"method", method}, {"path", path}, {"status", name[i+1:]}}, float64(m.Count())})
+				return
+			}
+			add(promName(name)+"_total", "counter", "Count of "+name, promSample{"", nil, float64(m.Count())})
+		case metrics.Meter:
+			add(promName(name)+"_total", "counter", "Count of "+name, promSample{"", nil, float64(m.Snapshot().Count())})
+		case metrics.Gauge:
+			if strings.HasPrefix(name, "spikes.prefix.") {
+				prefix, ok := gaugePrefix(name)
+				if !ok {
+					prefix = strings.TrimSuffix(strings.TrimPrefix(name, "spikes.prefix."), ".creates_per_minute")
+				}
+				add(promNamespace+"_spikes_prefix_creates_per_minute", "gauge", "Creates in the last minute per prefix",
+					promSample{"", [][2]string{{"prefix", prefix}}, float64(m.Value())})
+				return
+			}
+			add(promName(name), "gauge", "Value of "+name, promSample{"", nil, float64(m.Value())})
+		}
+	})
+
+	list := []*promFamily{}
+	for _, f := range families {
+		list = append(list, f)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].name < list[j].name })
+	return list
+}
+
+// The metric families in the Prometheus text exposition format
+func prometheusText(families []*promFamily) string {
+	var buf bytes.Buffer
+	for _, f := range families {
+		fmt.Fprintf(&buf, "# HELP %s %s\n", f.name, f.help)
+		fmt.Fprintf(&buf, "# TYPE %s %s\n", f.name, f.kind)
+		for _, s := range f.samples {
+			buf.WriteString(f.name + s.suffix)
+			if len(s.labels) > 0 {
+				pairs := []string{}
+				for _, l := range s.labels {
+					pairs = append(pairs, fmt.Sprintf(`%s="%s"`, l[0], promEscape(l[1])))
+				}
+				buf.WriteString("{" + strings.Join(pairs, ",") + "}")
+			}
+			fmt.Fprintf(&buf, " %v\n", s.value)
+		}
+	}
+	return buf.String()
+}
+
+func prometheusHandler(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, "GET") {
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	w.Write([]byte(prometheusText(promFamilies(metrics.DefaultRegistry))))
+}
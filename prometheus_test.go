@@ -0,0 +1,82 @@
+package main
+
+import (
+	"fmt"
+	"github.com/rcrowley/go-metrics"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPromRoute(t *testing.T) {
+	type testpair struct {
+		name   string
+		method string
+		path   string
+	}
+	var testCases = []testpair{
+		{"GET.json", "GET", "/json"},
+		{"GET.index_html", "GET", "/"},
+		{"DELETE.api.v1.datasources.foo", "DELETE", "/api/v1/datasources/foo"},
+		{"GET", "GET", "/"},
+	}
+	for _, test := range testCases {
+		if method, path := promRoute(test.name); method != test.method || path != test.path {
+			t.Fatal(fmt.Sprintf("Expected %v to be %v %v, got %v %v", test.name, test.method, test.path, method, path))
+		}
+	}
+}
+
+func TestPrometheus(t *testing.T) {
+	r := metrics.NewRegistry()
+	metrics.GetOrRegisterTimer("GET.json", r).Update(2 * time.Second)
+	metrics.GetOrRegisterTimer("GET.__all_reqs", r).Update(2 * time.Second)
+	metrics.GetOrRegisterCounter("tail.input_lines", r).Inc(42)
+	metrics.GetOrRegisterCounter("responses.GET.json.404", r).Inc(2)
+	metrics.GetOrRegisterGauge("spikes.prefix.app_payments.creates_per_minute", r).Update(7)
+	metrics.GetOrRegisterMeter("spikes.creates", r).Mark(3)
+
+	out := prometheusText(promFamilies(r))
+	for _, line := range []string{
+		"# TYPE graphite_news_http_request_duration_seconds summary",
+		`graphite_news_http_request_duration_seconds{method="GET",path="/json",quantile="0.5"} 2`,
+		`graphite_news_http_request_duration_seconds_sum{method="GET",path="/json"} 2`,
+		`graphite_news_http_request_duration_seconds_count{method="GET",path="/json"} 1`,
+		"# TYPE graphite_news_tail_input_lines_total counter",
+		"graphite_news_tail_input_lines_total 42",
+		`graphite_news_spikes_prefix_creates_per_minute{prefix="app_payments"} 7`,
+		"graphite_news_spikes_creates_total 3",
+		`graphite_news_http_responses_total{method="GET",path="/json",status="404"} 2`,
+	} {
+		if !strings.Contains(out, line+"\n") {
+			t.Fatal(fmt.Sprintf("Expected a line %v, got:\n%v", line, out))
+		}
+	}
+	if strings.Contains(out, "__all_reqs") || strings.Count(out, "# TYPE") != 5 {
+		t.Fatal(fmt.Sprintf("Expected 5 metric families and no aggregates, got:\n%v", out))
+	}
+}
+
+func TestPrometheusHandler(t *testing.T) {
+	req, _ := http.NewRequest("GET", "/metrics", nil)
+	w := httptest.NewRecorder()
+	prometheusHandler(w, req)
+	if w.Code != http.StatusOK || !strings.HasPrefix(w.Header().Get("Content-Type"), "text/plain; version=0.0.4") {
+		t.Fatal(fmt.Sprintf("Expected a 200 in the text format, got %v %v", w.Code, w.Header().Get("Content-Type")))
+	}
+}
+
+func TestPrometheusPrefixLabel(t *testing.T) {
+	d := newSpikeDetector(2)
+	for i := 0; i < 3; i++ {
+		d.add(fmt.Sprintf("app.prom_label.%v.latency", i))
+	}
+	d.tick(time.Now(), 0, 0, 0)
+
+	out := prometheusText(promFamilies(metrics.DefaultRegistry))
+	if line := `graphite_news_spikes_prefix_creates_per_minute{prefix="app.prom_label"} 3`; !strings.Contains(out, line+"\n") {
+		t.Fatal(fmt.Sprintf("Expected a line %v, got:\n%v", line, out))
+	}
+}
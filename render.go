@@ -0,0 +1,188 @@
+package main
+
+// Proxies /render/ to graphite-web (-px), so browsers only ever talk to
+// graphite-news, even when graphite-web sits on a network they can't reach.
+// Responses are kept in an LRU cache for a minute, identical requests that
+// come in while one is on its way to graphite-web wait for that one instead
+// of going out themselves, and an auth header can be added (-pa).
+
+import (
+	"container/list"
+	"fmt"
+	"github.com/rcrowley/go-metrics"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+type (
+	renderResponse struct {
+		status      int
+		contentType string
+		body        []byte
+		expires     time.Time
+	}
+
+	// LRU cache of render responses, most recently used at the front
+	renderCache struct {
+		*sync.Mutex
+		max     int
+		order   *list.List
+		entries map[string]*list.Element
+		pending map[string]*renderCall
+	}
+
+	renderCacheItem struct {
+		key  string
+		resp *renderResponse
+	}
+
+	// A request on its way to graphite-web, others wait on done for it
+	renderCall struct {
+		done chan struct{}
+		resp *renderResponse
+		err  error
+	}
+)
+
+const (
+	renderCacheTTL = time.Minute
+
+	// Responses bigger than this are passed on, but not cached
+	maxRenderCacheBody = 2 << 20
+)
+
+var renders = newRenderCache(200)
+
+func newRenderCache(max int) *renderCache {
+	return &renderCache{&sync.Mutex{}, max, list.New(), map[string]*list.Element{}, map[string]*renderCall{}}
+}
+
+// Returns the cached response for key, or fetches it with fetch. Concurrent
+// calls for the same key share one fetch.
+func (c *renderCache) get(key string, now time.Time, fetch func() (*renderResponse, error)) (*renderResponse, bool, error) {
+	c.Lock()
+	if e, ok := c.entries[key]; ok {
+		item := e.Value.(*renderCacheItem)
+		if now.Before(item.resp.expires) {
+			c.order.MoveToFront(e)
+			c.Unlock()
+			return item.resp, true, nil
+		}
+		c.order.Remove(e)
+		delete(c.entries, key)
+	}
+	if call, ok := c.pending[key]; ok {
+		c.Unlock()
+		<-call.done
+		return call.resp, true, call.err
+	}
+	call := &renderCall{done: make(chan struct{})}
+	c.pending[key] = call
+	c.Unlock()
+
+	call.resp, call.err = fetch()
+
+	c.Lock()
+	delete(c.pending, key)
+	if call.err == nil && call.resp.status == http.StatusOK && len(call.resp.body) <= maxRenderCacheBody && c.max > 0 {
+		c.entries[key] = c.order.PushFront(&renderCacheItem{key, call.resp})
+		for c.order.Len() > c.max {
+			oldest := c.order.Back()
+			c.order.Remove(oldest)
+			delete(c.entries, oldest.Value.(*renderCacheItem).key)
+		}
+	}
+	c.Unlock()
+	close(call.done)
+	return call.resp, false, call.err
+}
+
+// Splits a "Name: value" header as given to -pa
+func parseHeader(header string) (string, string, error) {
+	parts := strings.SplitN(header, ":", 2)
+	if len(parts) != 2 || len(strings.TrimSpace(parts[0])) == 0 {
+		return "", "", fmt.Errorf("header should look like 'Name: value', got '%v'", header)
+	}
+	return strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]), nil
+}
+
+// Client for requests to graphite-web
+func renderClient() *http.Client {
+	return &http.Client{Timeout: C.proxyTimeout}
+}
+
+// Asks graphite-web for a render
+func fetchRender(client *http.Client, url string) (*renderResponse, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(C.proxyAuthHeader) > 0 {
+		name, value, _ := parseHeader(C.proxyAuthHeader)
+		req.Header.Set(name, value)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &renderResponse{resp.StatusCode, resp.Header.Get("Content-Type"), body, time.Now().Add(renderCacheTTL)}, nil
+}
+
+func renderHandler(w http.ResponseWriter, r *http.Request) {
+	l := log.New(os.Stdout, "render	", myLogFormat)
+	hits := metrics.GetOrRegisterCounter("render.cache_hits", metrics.DefaultRegistry)
+	misses := metrics.GetOrRegisterCounter("render.cache_misses", metrics.DefaultRegistry)
+
+	if !C.ProxyRender {
+		writeAPIError(w, http.StatusNotFound, "not_found", "Proxying the render API is not enabled (-px)")
+		return
+	}
+	if !allowMethods(w, r, "GET") {
+		return
+	}
+
+	// ?backend= picks the graphite-web, see backends.go
+	q := r.URL.Query()
+	backend := backendURL(q.Get("backend"))
+	if len(backend) == 0 {
+		writeAPIError(w, http.StatusBadRequest, "unknown_backend", "No such backend: "+q.Get("backend"))
+		return
+	}
+	q.Del("backend")
+
+	// the same parameters in a different order are the same render
+	query := q.Encode()
+	client := renderClient()
+	resp, cached, err := renders.get(backend+"/render/?"+query, time.Now(), func() (*renderResponse, error) {
+		return fetchRender(client, backend+"/render/?"+query)
+	})
+	if err != nil {
+		l.Printf("Render of %v failed: %v", query, err)
+		writeAPIError(w, http.StatusBadGateway, "render_failed", err.Error())
+		return
+	}
+	if cached {
+		hits.Inc(1)
+	} else {
+		misses.Inc(1)
+	}
+
+	if len(resp.contentType) > 0 {
+		w.Header().Set("Content-Type", resp.contentType)
+	}
+	if resp.status == http.StatusOK {
+		w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", int(renderCacheTTL.Seconds())))
+	}
+	w.WriteHeader(resp.status)
+	w.Write(resp.body)
+}
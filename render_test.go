@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRenderCache(t *testing.T) {
+	c := newRenderCache(2)
+	now := time.Now()
+	fetches := 0
+	fetch := func(body string) func() (*renderResponse, error) {
+		return func() (*renderResponse, error) {
+			fetches++
+			return &renderResponse{http.StatusOK, "image/png", []byte(body), now.Add(renderCacheTTL)}, nil
+		}
+	}
+
+	c.get("a", now, fetch("a"))
+	c.get("b", now, fetch("b"))
+	c.get("a", now, fetch("a")) // a is now most recently used
+	c.get("c", now, fetch("c")) // pushes out b
+	if resp, cached, _ := c.get("a", now, fetch("a")); !cached || string(resp.body) != "a" || fetches != 3 {
+		t.Fatal(fmt.Sprintf("Expected a to be cached, fetched %v times", fetches))
+	}
+	if _, cached, _ := c.get("b", now, fetch("b")); cached || fetches != 4 {
+		t.Fatal("Expected least recently used b to be dropped")
+	}
+	if _, cached, _ := c.get("a", now.Add(2*renderCacheTTL), fetch("a")); cached {
+		t.Fatal("Expected expired response to be fetched again")
+	}
+
+	// errors are not cached
+	c.get("x", now, func() (*renderResponse, error) {
+		return &renderResponse{status: http.StatusInternalServerError}, nil
+	})
+	if _, cached, _ := c.get("x", now, fetch("x")); cached {
+		t.Fatal("Expected a failed render not to be cached")
+	}
+}
+
+func TestRenderProxy(t *testing.T) {
+	saved := renders
+	renders = newRenderCache(10)
+	defer func() { renders = saved }()
+
+	var lock sync.Mutex
+	requests := 0
+	release := make(chan bool)
+	graphite := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		requests++
+		lock.Unlock()
+		if r.Header.Get("Authorization") != "Basic dXNlcjpwYXNz" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		<-release
+		w.Header().Set("Content-Type", "image/png")
+		fmt.Fprintf(w, "png of %v", r.URL.Query().Get("target"))
+	}))
+	defer graphite.Close()
+	defer func(saved configuration) { C = saved }(C)
+	C.GraphiteURL = graphite.URL
+	C.ProxyRender = true
+	C.proxyAuthHeader = "Authorization: Basic dXNlcjpwYXNz"
+	C.proxyTimeout = 5 * time.Second
+
+	// identical requests while the first is underway share its response
+	var wg sync.WaitGroup
+	results := make([]*httptest.ResponseRecorder, 5)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			req, _ := http.NewRequest("GET", "/render/?target=local.random.diceroll&width=800", nil)
+			if i%2 == 1 {
+				req, _ = http.NewRequest("GET", "/render/?width=800&target=local.random.diceroll", nil)
+			}
+			results[i] = httptest.NewRecorder()
+			renderHandler(results[i], req)
+		}(i)
+	}
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	for _, w := range results {
+		if w.Code != http.StatusOK || w.Body.String() != "png of local.random.diceroll" || w.Header().Get("Content-Type") != "image/png" {
+			t.Fatal(fmt.Sprintf("Unexpected proxied render: %v %v", w.Code, w.Body.String()))
+		}
+	}
+	if requests != 1 {
+		t.Fatal(fmt.Sprintf("Expected identical renders to be coalesced and cached, graphite-web got %v requests", requests))
+	}
+
+	C.proxyAuthHeader = ""
+	req, _ := http.NewRequest("GET", "/render/?target=other", nil)
+	w := httptest.NewRecorder()
+	renderHandler(w, req)
+	if w.Code != http.StatusUnauthorized {
+		t.Fatal(fmt.Sprintf("Expected graphite-web's status to be passed on, got %v", w.Code))
+	}
+
+	C.ProxyRender = false
+	w = httptest.NewRecorder()
+	renderHandler(w, req)
+	if w.Code != http.StatusNotFound {
+		t.Fatal(fmt.Sprintf("Expected 404 with the proxy disabled, got %v", w.Code))
+	}
+}
@@ -0,0 +1,202 @@
+package main
+
+// Renders small sparklines as SVG on /spark/{name}.svg, so the list can
+// show a thumbnail trend for every new data source without hammering
+// graphite-web. Points come from the whisper file if we know it, otherwise
+// from the render API as JSON. Rendered sparklines are cached for a minute.
+//
+// Takes ?from= (like /data/, default -24h), ?width= and ?height= in pixels.
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/rcrowley/go-metrics"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type (
+	sparkCacheEntry struct {
+		svg     []byte
+		expires time.Time
+	}
+
+	sparkCache struct {
+		*sync.Mutex
+		entries map[string]sparkCacheEntry
+	}
+)
+
+const (
+	sparkCacheTTL  = time.Minute
+	maxSparkCache  = 1000
+	defaultSparkW  = 120
+	defaultSparkH  = 24
+	maxSparkPixels = 1000
+)
+
+var sparks = &sparkCache{&sync.Mutex{}, map[string]sparkCacheEntry{}}
+
+func (c *sparkCache) get(key string, now time.Time) ([]byte, bool) {
+	c.Lock()
+	defer c.Unlock()
+	e, ok := c.entries[key]
+	if !ok || now.After(e.expires) {
+		return nil, false
+	}
+	return e.svg, true
+}
+
+func (c *sparkCache) put(key string, svg []byte, now time.Time) {
+	c.Lock()
+	defer c.Unlock()
+	if len(c.entries) >= maxSparkCache {
+		for k, e := range c.entries {
+			if now.After(e.expires) {
+				delete(c.entries, k)
+			}
+		}
+	}
+	if len(c.entries) >= maxSparkCache {
+		return // all fresh, don't grow
+	}
+	c.entries[key] = sparkCacheEntry{svg, now.Add(sparkCacheTTL)}
+}
+
+// Values of a data source since from, from its whisper file or the render
+// API (with the auth header and timeout of the proxy, -pa and -pt)
+func sparkValues(ds Datasource, from time.Time, now time.Time) ([]*float64, error) {
+	if len(ds.filename) > 0 {
+		wsp, err := openWhisper(ds.filename)
+		if err != nil {
+			return nil, err
+		}
+		defer wsp.Close()
+		values, _, _, err := wsp.fetch(from.Unix(), now.Unix(), now.Unix())
+		return values, err
+	}
+
+	v := url.Values{}
+	v.Set("target", ds.Name)
+	v.Set("from", strconv.FormatInt(from.Unix(), 10))
+	v.Set("format", "json")
+	resp, err := fetchRender(renderClient(), graphiteURLFor(ds)+"/render/?"+v.Encode())
+	if err != nil {
+		return nil, err
+	}
+	if resp.status != http.StatusOK {
+		return nil, fmt.Errorf("render API returned %v %v", resp.status, http.StatusText(resp.status))
+	}
+	var series []dataSeries
+	if err := json.Unmarshal(resp.body, &series); err != nil {
+		return nil, err
+	}
+	var values []*float64
+	if len(series) > 0 {
+		for _, p := range series[0].Datapoints {
+			if f, ok := p[0].(float64); ok {
+				values = append(values, &f)
+			} else {
+				values = append(values, nil)
+			}
+		}
+	}
+	return values, nil
+}
+
+// Draws the values as a line, with gaps where there are none. Without any
+// values it is a flat grey line.
+func renderSparkline(values []*float64, width int, height int) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`, width, height, width, height)
+
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		if v != nil {
+			min, max = math.Min(min, *v), math.Max(max, *v)
+		}
+	}
+	if math.IsInf(min, 1) {
+		fmt.Fprintf(&buf, `<line x1="0" y1="%d" x2="%d" y2="%d" stroke="#ccc" stroke-width="1"/></svg>`, height/2, width, height/2)
+		return buf.Bytes()
+	}
+	if max == min {
+		max, min = max+1, min-1
+	}
+
+	var line []string
+	flush := func() {
+		if len(line) == 1 {
+			line = append(line, line[0]) // a single point still shows as a dot
+		}
+		if len(line) > 0 {
+			fmt.Fprintf(&buf, `<polyline fill="none" stroke="#337ab7" stroke-width="1" stroke-linecap="round" points="%s"/>`, strings.Join(line, " "))
+		}
+		line = nil
+	}
+	step := float64(width-2) / math.Max(1, float64(len(values)-1))
+	for i, v := range values {
+		if v == nil {
+			flush()
+			continue
+		}
+		x := 1 + float64(i)*step
+		y := 1 + float64(height-2)*(max-*v)/(max-min)
+		line = append(line, fmt.Sprintf("%.1f,%.1f", x, y))
+	}
+	flush()
+	buf.WriteString("</svg>")
+	return buf.Bytes()
+}
+
+func sparkHandler(w http.ResponseWriter, r *http.Request) {
+	m := metrics.GetOrRegisterCounter("spark.cache_hits", metrics.DefaultRegistry)
+	if !allowMethods(w, r, "GET") {
+		return
+	}
+	name := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/spark/"), ".svg")
+	ds := getDSbyName(name)
+	if len(ds.Name) == 0 {
+		writeAPIError(w, http.StatusNotFound, "datasource_not_found", "No such data source: "+name)
+		return
+	}
+
+	q := r.URL.Query()
+	width, _ := strconv.Atoi(q.Get("width"))
+	height, _ := strconv.Atoi(q.Get("height"))
+	if width < 10 || width > maxSparkPixels {
+		width = defaultSparkW
+	}
+	if height < 10 || height > maxSparkPixels {
+		height = defaultSparkH
+	}
+	now := time.Now()
+	from, err := parseDataTime(q.Get("from"), now, now.Add(-24*time.Hour))
+	if err != nil {
+		writeAPIError(w, http.StatusBadRequest, "bad_from", "Invalid from: "+err.Error())
+		return
+	}
+
+	key := fmt.Sprintf("%s|%s|%d|%d", name, q.Get("from"), width, height)
+	svg, ok := sparks.get(key, now)
+	if ok {
+		m.Inc(1)
+	} else {
+		values, err := sparkValues(ds, from, now)
+		if err != nil {
+			writeAPIError(w, http.StatusBadGateway, "no_data", err.Error())
+			return
+		}
+		svg = renderSparkline(values, width, height)
+		sparks.put(key, svg, now)
+	}
+	w.Header().Set("Content-Type", "image/svg+xml")
+	w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", int(sparkCacheTTL.Seconds())))
+	w.Write(svg)
+}
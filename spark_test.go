@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRenderSparkline(t *testing.T) {
+	one, two, three := 1.0, 2.0, 3.0
+	svg := string(renderSparkline([]*float64{&one, &two, nil, &three}, 100, 20))
+	if strings.Count(svg, "<polyline") != 2 || !strings.Contains(svg, `points="1.0,19.0 33.7,10.0"`) || !strings.Contains(svg, `points="99.0,1.0 99.0,1.0"`) {
+		t.Fatal(fmt.Sprintf("Expected 2 lines with a gap in between, got %v", svg))
+	}
+	if svg := string(renderSparkline(nil, 100, 20)); !strings.Contains(svg, "<line") {
+		t.Fatal(fmt.Sprintf("Expected a flat line without values, got %v", svg))
+	}
+}
+
+func TestSparkHandler(t *testing.T) {
+	State.Vals = nil // start fresh
+	defer func() { State.Vals = nil }()
+	saved := sparks
+	sparks = &sparkCache{&sync.Mutex{}, map[string]sparkCacheEntry{}}
+	defer func() { sparks = saved }()
+
+	var renders int
+	ts := time.Now().Unix() / 60 * 60
+	graphite := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		renders++
+		if r.URL.Path != "/render/" || r.URL.Query().Get("target") != "app.ingested.count" || r.URL.Query().Get("format") != "json" || r.Header.Get("Authorization") != "Bearer secret" {
+			t.Error(fmt.Sprintf("Unexpected render request: %v", r.URL))
+		}
+		fmt.Fprintf(w, `[{"target": "app.ingested.count", "datapoints": [[1, %d], [null, %d], [3, %d]]}]`, ts-120, ts-60, ts)
+	}))
+	defer graphite.Close()
+	defer func(saved configuration) { C = saved }(C)
+	C.GraphiteURL = graphite.URL
+	C.proxyAuthHeader = "Authorization: Bearer secret"
+
+	filename := writeTestWhisper(t, [][2]uint32{{60, 1440}}, []whisperPoint{{uint32(ts - 60), 1}, {uint32(ts), 2}})
+	defer os.Remove(filename)
+	addItemToState(Datasource{Name: "local.random.diceroll", filename: filename})
+	addItemToState(Datasource{Name: "app.ingested.count"})
+
+	get := func(url string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("GET", url, nil)
+		w := httptest.NewRecorder()
+		sparkHandler(w, req)
+		return w
+	}
+
+	w := get("/spark/local.random.diceroll.svg?width=60")
+	if w.Code != http.StatusOK || w.Header().Get("Content-Type") != "image/svg+xml" || !strings.Contains(w.Body.String(), `width="60"`) || !strings.Contains(w.Body.String(), "<polyline") {
+		t.Fatal(fmt.Sprintf("Expected sparkline from the whisper file, got %v %v", w.Code, w.Body.String()))
+	}
+	if renders > 0 {
+		t.Fatal("Render API was asked for a data source with a whisper file")
+	}
+
+	for i := 0; i < 3; i++ {
+		w = get("/spark/app.ingested.count.svg")
+		if w.Code != http.StatusOK || strings.Count(w.Body.String(), "<polyline") != 2 {
+			t.Fatal(fmt.Sprintf("Expected sparkline from the render API, got %v %v", w.Code, w.Body.String()))
+		}
+	}
+	if renders != 1 {
+		t.Fatal(fmt.Sprintf("Expected sparkline to be cached, render API was asked %v times", renders))
+	}
+
+	if w = get("/spark/no.such.thing.svg"); w.Code != http.StatusNotFound {
+		t.Fatal(fmt.Sprintf("Expected 404 for unknown data source, got %v", w.Code))
+	}
+}
@@ -0,0 +1,226 @@
+package main
+
+// Detects metric explosions: someone creating thousands of metrics in a
+// minute, usually with an ID or timestamp in the name. Counts creates per
+// minute overall and per prefix, keeps a moving baseline (mean and
+// deviation) of those counts, and raises an alert when a count goes over a
+// fixed threshold or too far above its baseline. Alerts go out through the
+// configured webhooks and chat routes. The rates are also registered as
+// metrics, so they get reported along with the rest (-r).
+
+import (
+	"fmt"
+	"github.com/rcrowley/go-metrics"
+	"log"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+type (
+	// Moving average and variance of creates per minute
+	baseline struct {
+		mean     float64
+		variance float64
+		minutes  int // number of minutes seen, baseline is unreliable at first
+	}
+
+	// Counts creates and keeps baselines, overall (prefix "") and per prefix
+	spikeDetector struct {
+		*sync.Mutex
+		depth     int
+		counts    map[string]int
+		baselines map[string]*baseline
+		alerted   map[string]time.Time // last alert per prefix, to not repeat ourselves
+	}
+)
+
+const (
+	// Weight of the latest minute in the moving baseline
+	baselineAlpha = 0.1
+
+	// Minutes to learn the baseline before alerting on deviations from it
+	baselineWarmup = 10
+
+	// Ignore deviations below this many creates per minute, too noisy
+	minAnomalyCount = 10
+
+	// Don't alert on the same prefix again within this period
+	alertCooldown = 10 * time.Minute
+
+	// Maximum number of prefixes to register gauges for, to not create
+	// an explosion of metrics ourselves
+	maxPrefixGauges = 50
+)
+
+var (
+	detector = newSpikeDetector(2)
+
+	// Prefix of every gauge registered per prefix, by metric name. The
+	// name has the dots in the prefix replaced, /metrics wants it back.
+	prefixGauges = struct {
+		*sync.RWMutex
+		prefixes map[string]string
+	}{&sync.RWMutex{}, map[string]string{}}
+)
+
+func newSpikeDetector(depth int) *spikeDetector {
+	return &spikeDetector{&sync.Mutex{}, depth, map[string]int{}, map[string]*baseline{}, map[string]time.Time{}}
+}
+
+// Prefix of a name at the depth of the detector (app.requests for
+// app.requests.1234.latency at depth 2)
+func (d *spikeDetector) prefix(name string) string {
+	parts := strings.SplitN(name, ".", d.depth+1)
+	if len(parts) > d.depth {
+		parts = parts[:d.depth]
+	}
+	return strings.Join(parts, ".")
+}
+
+func (d *spikeDetector) add(name string) {
+	d.Lock()
+	defer d.Unlock()
+	d.counts[""]++
+	d.counts[d.prefix(name)]++
+}
+
+// Updates the baseline with the count of the last minute, returns true if
+// that count is an anomaly compared to the baseline before it.
+func (b *baseline) update(count int, sigma float64) bool {
+	anomaly := sigma > 0 && b.minutes >= baselineWarmup && count >= minAnomalyCount &&
+		float64(count) > b.mean+sigma*math.Sqrt(b.variance)
+
+	diff := float64(count) - b.mean
+	b.mean += baselineAlpha * diff
+	b.variance = (1 - baselineAlpha) * (b.variance + baselineAlpha*diff*diff)
+	b.minutes++
+	return anomaly
+}
+
+// Closes the current minute: updates baselines and metrics, and returns
+// the alerts for any thresholds crossed. threshold applies to all creates,
+// prefixThreshold to those of a single prefix, 0 disables either.
+func (d *spikeDetector) tick(now time.Time, threshold int, prefixThreshold int, sigma float64) []alert {
+	d.Lock()
+	defer d.Unlock()
+	var alerts []alert
+
+	// prefixes that saw no creates this minute still need their baseline
+	// updated (with a 0), forget them once their baseline is about 0 too
+	for prefix, b := range d.baselines {
+		if _, ok := d.counts[prefix]; !ok {
+			d.counts[prefix] = 0
+			if prefix != "" && b.mean < 0.01 {
+				delete(d.baselines, prefix)
+				delete(d.counts, prefix)
+			}
+		}
+	}
+
+	for prefix, last := range d.alerted {
+		if now.Sub(last) >= alertCooldown {
+			delete(d.alerted, prefix)
+		}
+	}
+
+	for prefix, count := range d.counts {
+		b, ok := d.baselines[prefix]
+		if !ok {
+			b = &baseline{}
+			d.baselines[prefix] = b
+		}
+		mean := b.mean
+		anomaly := b.update(count, sigma)
+
+		limit := prefixThreshold
+		if prefix == "" {
+			limit = threshold
+		}
+		var reason string
+		if limit > 0 && count > limit {
+			reason = fmt.Sprintf("%v creates in a minute, over the threshold of %v", count, limit)
+		} else if anomaly {
+			reason = fmt.Sprintf("%v creates in a minute, while %.1f per minute is normal", count, mean)
+		} else {
+			continue
+		}
+		if last, ok := d.alerted[prefix]; ok && now.Sub(last) < alertCooldown {
+			continue
+		}
+		d.alerted[prefix] = now
+		alerts = append(alerts, alert{Time: now, Kind: "spike", Prefix: prefix, Count: count, Threshold: limit, Baseline: mean, Reason: reason})
+	}
+
+	d.updateMetrics()
+	d.counts = map[string]int{}
+	sort.Slice(alerts, func(i, j int) bool { return alerts[i].Prefix < alerts[j].Prefix })
+	return alerts
+}
+
+// Registers the creates of the last minute as gauges, for all data sources
+// and for the busiest prefixes.
+func (d *spikeDetector) updateMetrics() {
+	metrics.GetOrRegisterGauge("spikes.creates_per_minute", metrics.DefaultRegistry).Update(int64(d.counts[""]))
+
+	var prefixes []string
+	for prefix := range d.counts {
+		if prefix != "" {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	sort.Slice(prefixes, func(i, j int) bool { return d.counts[prefixes[i]] > d.counts[prefixes[j]] })
+	for _, prefix := range prefixes {
+		name := fmt.Sprintf("spikes.prefix.%s.creates_per_minute", strings.Replace(prefix, ".", "_", -1))
+		if g := metrics.DefaultRegistry.Get(name); g != nil {
+			g.(metrics.Gauge).Update(int64(d.counts[prefix]))
+			continue
+		}
+		prefixGauges.Lock()
+		register := len(prefixGauges.prefixes) < maxPrefixGauges
+		if register {
+			prefixGauges.prefixes[name] = prefix
+		}
+		prefixGauges.Unlock()
+		if register {
+			metrics.GetOrRegisterGauge(name, metrics.DefaultRegistry).Update(int64(d.counts[prefix]))
+		}
+	}
+}
+
+// Prefix a gauge registered by updateMetrics counts the creates of
+func gaugePrefix(name string) (string, bool) {
+	prefixGauges.RLock()
+	defer prefixGauges.RUnlock()
+	prefix, ok := prefixGauges.prefixes[name]
+	return prefix, ok
+}
+
+// Feeds the detector with new data sources, and checks it every minute
+func detectSpikes() {
+	l := log.New(os.Stdout, "spikes	", myLogFormat)
+	m := metrics.GetOrRegisterMeter("spikes.creates", metrics.DefaultRegistry)
+	detector.Lock()
+	if C.spikePrefixDepth > 0 {
+		detector.depth = C.spikePrefixDepth
+	}
+	detector.Unlock()
+
+	in := subscribe()
+	ticker := time.NewTicker(time.Minute)
+	for {
+		select {
+		case ds := <-in:
+			m.Mark(1)
+			detector.add(ds.Name)
+		case now := <-ticker.C:
+			for _, a := range detector.tick(now, C.spikeThreshold, C.spikePrefixThreshold, C.spikeSigma) {
+				l.Printf("ALERT for prefix '%v': %v", a.Prefix, a.Reason)
+				go sendAlert(a)
+			}
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"fmt"
+	"github.com/rcrowley/go-metrics"
+	"testing"
+	"time"
+)
+
+func TestSpikeThresholds(t *testing.T) {
+	d := newSpikeDetector(2)
+	now := time.Now()
+
+	for i := 0; i < 30; i++ {
+		d.add(fmt.Sprintf("app.requests.%v.latency", i))
+	}
+	d.add("app.checkout.count")
+
+	alerts := d.tick(now, 25, 20, 0)
+	if len(alerts) != 2 || alerts[0].Prefix != "" || alerts[1].Prefix != "app.requests" || alerts[1].Count != 30 {
+		t.Fatal(fmt.Sprintf("Expected an overall and an app.requests alert, got %+v", alerts))
+	}
+	if g := metrics.DefaultRegistry.Get("spikes.prefix.app_requests.creates_per_minute"); g == nil || g.(metrics.Gauge).Value() != 30 {
+		t.Fatal(fmt.Sprintf("Creates per minute of app.requests were not registered as a metric: %+v", g))
+	}
+
+	// same again a minute later should not alert again (cooldown)
+	for i := 0; i < 30; i++ {
+		d.add(fmt.Sprintf("app.requests.%v.count", i))
+	}
+	if alerts := d.tick(now.Add(time.Minute), 25, 20, 0); len(alerts) != 0 {
+		t.Fatal(fmt.Sprintf("Alerted again within the cooldown: %+v", alerts))
+	}
+}
+
+func TestSpikeBaseline(t *testing.T) {
+	d := newSpikeDetector(2)
+	now := time.Now()
+
+	// learn that app.normal gets about 5 creates per minute
+	for minute := 0; minute < baselineWarmup+5; minute++ {
+		for i := 0; i < 4+minute%3; i++ {
+			d.add(fmt.Sprintf("app.normal.%v", i))
+		}
+		if alerts := d.tick(now.Add(time.Duration(minute)*time.Minute), 0, 0, 4); len(alerts) != 0 {
+			t.Fatal(fmt.Sprintf("Alerted on normal behaviour: %+v", alerts))
+		}
+	}
+
+	for i := 0; i < 200; i++ {
+		d.add(fmt.Sprintf("app.normal.%v", i))
+	}
+	alerts := d.tick(now.Add(time.Hour), 0, 0, 4)
+	if len(alerts) != 2 || alerts[1].Prefix != "app.normal" || alerts[1].Baseline < 4 || alerts[1].Baseline > 6 {
+		t.Fatal(fmt.Sprintf("Expected an alert on deviating from the baseline, got %+v", alerts))
+	}
+}
+
+func TestAlertMetrics(t *testing.T) {
+	spikes := metrics.GetOrRegisterCounter("spikes.alerts", metrics.DefaultRegistry)
+	lint := metrics.GetOrRegisterCounter("alerts.lint", metrics.DefaultRegistry)
+	beforeSpikes, beforeLint := spikes.Count(), lint.Count()
+
+	sendAlert(alert{Time: time.Now(), Kind: "spike", Prefix: "app.requests", Count: 30})
+	sendAlert(alert{Time: time.Now(), Kind: "lint", Prefix: "app.Requests", Count: 1})
+
+	if spikes.Count()-beforeSpikes != 1 || lint.Count()-beforeLint != 1 {
+		t.Fatal(fmt.Sprintf("Expected one spikes.alerts and one alerts.lint, got %v and %v", spikes.Count()-beforeSpikes, lint.Count()-beforeLint))
+	}
+}
+
+func TestSpikeGaugeLimit(t *testing.T) {
+	saved := prefixGauges.prefixes
+	prefixGauges.prefixes = map[string]string{}
+	defer func() { prefixGauges.prefixes = saved }()
+
+	d := newSpikeDetector(2)
+	now := time.Now()
+	// new prefixes every minute should not add up to more gauges
+	for minute := 0; minute < 3; minute++ {
+		for i := 0; i < maxPrefixGauges; i++ {
+			d.add(fmt.Sprintf("gaugelimit.m%vp%v.count", minute, i))
+		}
+		d.tick(now.Add(time.Duration(minute)*time.Minute), 0, 0, 0)
+	}
+	if gauges := len(prefixGauges.prefixes); gauges != maxPrefixGauges {
+		t.Fatal(fmt.Sprintf("Expected at most %v prefix gauges, got %v", maxPrefixGauges, gauges))
+	}
+}
+
+func TestSpikeAlertedPruned(t *testing.T) {
+	d := newSpikeDetector(2)
+	now := time.Now()
+	for i := 0; i < 30; i++ {
+		d.add(fmt.Sprintf("app.pruned.%v", i))
+	}
+	d.tick(now, 0, 20, 0)
+	d.tick(now.Add(alertCooldown), 0, 20, 0)
+	if _, ok := d.alerted["app.pruned"]; ok {
+		t.Fatal(fmt.Sprintf("Expected alerts past their cooldown to be forgotten, got %+v", d.alerted))
+	}
+}
@@ -0,0 +1,240 @@
+package main
+
+// Built-in syslog receiver, for carbon daemons that log to syslog and not
+// to files. Understands both RFC5424 and (the older BSD style) RFC3164
+// messages, over UDP, TCP (newline or octet-count framed) and unix sockets.
+// The message body goes through parseLine like any tailed line would.
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/rcrowley/go-metrics"
+	"io"
+	"log"
+	"net"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type (
+	// The parts of a syslog message we care about
+	syslogMessage struct {
+		Hostname string // host that generated the message
+		AppName  string // f.ex. carbon-cache-a, the TAG in RFC3164 terms
+		Message  string // the free form message, e.g. the carbon log line
+	}
+)
+
+var (
+	// <PRI>VERSION TIMESTAMP HOSTNAME APP-NAME PROCID MSGID SD MSG
+	rfc5424 = regexp.MustCompile(`^<[0-9]{1,3}>[0-9]{1,2} (\S+) (\S+) (\S+) (\S+) (\S+) (.*)$`)
+
+	// <PRI>Mmm dd hh:mm:ss HOSTNAME TAG[PID]: MSG, where glibc's syslog(3)
+	// leaves out the HOSTNAME when it writes to /dev/log
+	rfc3164 = regexp.MustCompile(`^<[0-9]{1,3}>([A-Z][a-z]{2} [ 0-9][0-9] [0-9]{2}:[0-9]{2}:[0-9]{2}) (?:([^:\[\s]+) )?([^:\[ ]+)(\[[0-9]+\])?: ?(.*)$`)
+)
+
+// Parses a single syslog message, returns false if it is neither RFC5424
+// nor RFC3164.
+func parseSyslog(line string) (syslogMessage, bool) {
+	line = strings.TrimRight(line, "\r\n\x00")
+
+	if match := rfc5424.FindStringSubmatch(line); len(match) > 0 {
+		msg := syslogMessage{Hostname: nilValue(match[2]), AppName: nilValue(match[3])}
+		msg.Message = skipStructuredData(match[6])
+		return msg, true
+	}
+
+	if match := rfc3164.FindStringSubmatch(line); len(match) > 0 {
+		return syslogMessage{Hostname: match[2], AppName: match[3], Message: match[5]}, true
+	}
+	return syslogMessage{}, false
+}
+
+// RFC5424 uses a dash to indicate a field has no value
+func nilValue(s string) string {
+	if s == "-" {
+		return ""
+	}
+	return s
+}
+
+// Strips the STRUCTURED-DATA part from the front of a RFC5424 message,
+// which is either a dash or one or more [elements], with possibly escaped
+// brackets inside quoted values. Also drops the UTF-8 byte order mark.
+func skipStructuredData(s string) string {
+	if strings.HasPrefix(s, "-") {
+		s = s[1:]
+	} else {
+		inElement, inValue, escaped := false, false, false
+		i := 0
+	loop:
+		for ; i < len(s); i++ {
+			switch {
+			case escaped:
+				escaped = false
+			case inValue && s[i] == '\\':
+				escaped = true
+			case inElement && s[i] == '"':
+				inValue = !inValue
+			case !inElement && s[i] == '[':
+				inElement = true
+			case !inValue && s[i] == ']':
+				inElement = false
+			case !inElement:
+				break loop
+			}
+		}
+		s = s[i:]
+	}
+	s = strings.TrimPrefix(s, " ")
+	return strings.TrimPrefix(s, "\xef\xbb\xbf")
+}
+
+// Feeds a raw syslog message into the regular parsing, recording where it
+// came from as the origin of the data source. Messages on a unix socket
+// without a hostname come from this host.
+func handleSyslog(line string, addr string) {
+	m := metrics.GetOrRegisterCounter("syslog.invalid_messages", metrics.DefaultRegistry)
+	msg, ok := parseSyslog(line)
+	if !ok {
+		m.Inc(1)
+		return
+	}
+	if len(msg.Hostname) == 0 && strings.HasPrefix(addr, "unix://") {
+		msg.Hostname, _ = os.Hostname()
+	}
+	parseLine(msg.Message, Origin{Logfile: addr, Instance: msg.AppName, Host: msg.Hostname})
+}
+
+// Starts a listener for every configured syslog address, these look like
+// udp://:514, tcp://:514 or unix:///dev/log (a datagram socket)
+func listenSyslog(c chan string) {
+	l := log.New(os.Stdout, "syslog	", myLogFormat)
+
+	for _, addr := range C.syslogAddrs {
+		parts := strings.SplitN(addr, "://", 2)
+		if len(parts) != 2 {
+			c <- fmt.Sprintf("Invalid syslog address (expected f.ex. udp://:514): %v", addr)
+			return
+		}
+
+		var err error
+		switch parts[0] {
+		case "tcp":
+			var ln net.Listener
+			if ln, err = net.Listen("tcp", parts[1]); err == nil {
+				go serveSyslogStream(c, ln, addr)
+			}
+		case "udp":
+			var pc net.PacketConn
+			if pc, err = net.ListenPacket("udp", parts[1]); err == nil {
+				go serveSyslogPackets(c, pc, addr)
+			}
+		case "unix":
+			var pc net.PacketConn
+			if err = removeStaleSocket(parts[1]); err != nil {
+				break
+			}
+			if pc, err = net.ListenPacket("unixgram", parts[1]); err == nil {
+				go serveSyslogPackets(c, pc, addr)
+			}
+		default:
+			err = fmt.Errorf("Unknown syslog protocol %v in %v", parts[0], addr)
+		}
+
+		if err != nil {
+			c <- fmt.Sprintf("%s", err)
+			return
+		}
+		l.Printf("Listening for syslog messages on %v", addr)
+	}
+}
+
+// Removes a socket left behind by an earlier run, but never one something
+// is still listening on (like the system logger on /dev/log), nor anything
+// that isn't a socket at all
+func removeStaleSocket(path string) error {
+	fi, err := os.Lstat(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if fi.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("%v exists and is not a socket, pick another socket", path)
+	}
+	if conn, err := net.Dial("unixgram", path); err == nil {
+		conn.Close()
+		return fmt.Errorf("Something is already listening on %v, stop it or pick another socket", path)
+	}
+	return os.Remove(path)
+}
+
+func serveSyslogPackets(c chan string, pc net.PacketConn, addr string) {
+	buf := make([]byte, maxDatagramSize)
+	for {
+		n, _, err := pc.ReadFrom(buf)
+		if err != nil {
+			c <- fmt.Sprintf("%s", err)
+			return
+		}
+		handleSyslog(string(buf[:n]), addr)
+	}
+}
+
+func serveSyslogStream(c chan string, ln net.Listener, addr string) {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			c <- fmt.Sprintf("%s", err)
+			return
+		}
+		go readSyslogStream(conn, addr)
+	}
+}
+
+// Reads syslog messages from a stream, these are either framed by a
+// trailing newline, or prefixed with their length (RFC6587 octet counting)
+func readSyslogStream(conn net.Conn, addr string) {
+	l := log.New(os.Stdout, "syslog	", myLogFormat)
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	for {
+		first, err := r.Peek(1)
+		if err != nil {
+			if err != io.EOF {
+				l.Printf("Error reading from %v: %v", conn.RemoteAddr(), err)
+			}
+			return
+		}
+
+		var line string
+		if first[0] >= '0' && first[0] <= '9' {
+			length, err := r.ReadString(' ')
+			if err != nil {
+				return
+			}
+			n, err := strconv.Atoi(strings.TrimSpace(length))
+			if err != nil || n > maxDatagramSize {
+				l.Printf("Invalid octet count from %v: %v", conn.RemoteAddr(), length)
+				return
+			}
+			buf := make([]byte, n)
+			if _, err := io.ReadFull(r, buf); err != nil {
+				return
+			}
+			line = string(buf)
+		} else {
+			line, err = r.ReadString('\n')
+			if err != nil && len(line) == 0 {
+				return
+			}
+		}
+		handleSyslog(line, addr)
+	}
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseSyslog(t *testing.T) {
+	type testpair struct {
+		line string
+		msg  syslogMessage
+	}
+
+	var testCases = []testpair{
+		{"<30>1 2014-09-13T23:10:56.003Z carbon1 carbon-cache-a 1234 - - creating database file /x.wsp",
+			syslogMessage{"carbon1", "carbon-cache-a", "creating database file /x.wsp"}},
+		{`<30>1 2014-09-13T23:10:56Z carbon1 carbon-cache-a - - [meta x="a \] b"][other] [creates] creating`,
+			syslogMessage{"carbon1", "carbon-cache-a", "[creates] creating"}},
+		{"<30>1 - - - - - - \xef\xbb\xbfhello\n",
+			syslogMessage{"", "", "hello"}},
+		{"<30>Sep 13 23:10:56 carbon2 carbon-cache-b[1234]: creating database file /y.wsp",
+			syslogMessage{"carbon2", "carbon-cache-b", "creating database file /y.wsp"}},
+		{"<30>Sep  3 23:10:56 carbon2 carbon: hello", syslogMessage{"carbon2", "carbon", "hello"}},
+		{"<14>Oct 19 10:00:00 carbon-cache[123]: creating database file /z.wsp",
+			syslogMessage{"", "carbon-cache", "creating database file /z.wsp"}},
+		{"<14>Oct 19 10:00:00 carbon: error: no such file", syslogMessage{"", "carbon", "error: no such file"}},
+	}
+
+	for _, test := range testCases {
+		msg, ok := parseSyslog(test.line)
+		if !ok || msg != test.msg {
+			t.Fatal(fmt.Sprintf("Parsing syslog message [%q] gave %+v, expected %+v", test.line, msg, test.msg))
+		}
+	}
+
+	if _, ok := parseSyslog("creating database file /y.wsp"); ok {
+		t.Fatal("Parsed a line without a syslog header as valid syslog")
+	}
+}
+
+func TestSyslogStreamFraming(t *testing.T) {
+	State.Vals = nil // start fresh
+	defer func() { State.Vals = nil }()
+
+	msg := "<30>1 2014-09-13T23:10:56Z carbon1 carbon-cache-a - - - creating database file /opt/graphite/storage/whisper/syslog/%v.wsp (archive=[(60, 525600)] xff=None agg=None)"
+	counted := fmt.Sprintf(msg, "counted")
+	server, client := net.Pipe()
+	go func() {
+		fmt.Fprintf(client, "%d %s", len(counted), counted)
+		fmt.Fprintf(client, msg+"\n", "newline")
+		client.Close()
+	}()
+	readSyslogStream(server, "tcp://:514")
+
+	for _, name := range []string{"syslog.counted", "syslog.newline"} {
+		if ds := getDSbyName(name); ds.Host != "carbon1" || ds.Instance != "carbon-cache-a" || ds.Logfile != "tcp://:514" {
+			t.Fatal(fmt.Sprintf("Syslog message did not end up in state with the right origin: %v %+v", name, ds))
+		}
+	}
+}
+
+func TestSyslogLocalHostname(t *testing.T) {
+	State.Vals = nil // start fresh
+	defer func() { State.Vals = nil }()
+
+	hostname, _ := os.Hostname()
+	handleSyslog("<14>Oct 19 10:00:00 carbon-cache[123]: creating database file /opt/graphite/storage/whisper/syslog/local.wsp (archive=[(60, 525600)] xff=None agg=None)", "unix:///dev/log")
+	if ds := getDSbyName("syslog.local"); ds.Host != hostname || ds.Instance != "carbon-cache" {
+		t.Fatal(fmt.Sprintf("Expected a message without hostname on a unix socket to be from %v, got %+v", hostname, ds))
+	}
+}
+
+func TestRemoveStaleSocket(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "graphite-news")
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "log")
+
+	if err := removeStaleSocket(path); err != nil {
+		t.Fatal(fmt.Sprintf("Expected a missing socket to be fine, got %v", err))
+	}
+
+	pc, err := net.ListenPacket("unixgram", path)
+	if err != nil {
+		t.Fatal(fmt.Sprintf("Could not listen on %v: %v", path, err))
+	}
+	if err := removeStaleSocket(path); err == nil {
+		t.Fatal("Expected a socket in use to be left alone")
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Fatal(fmt.Sprintf("Socket in use was removed: %v", err))
+	}
+
+	// closing a unixgram socket leaves the file behind, like a crash would
+	pc.Close()
+	if _, err := os.Stat(path); err != nil {
+		t.Fatal(fmt.Sprintf("Expected the socket file to be left behind: %v", err))
+	}
+	if err := removeStaleSocket(path); err != nil {
+		t.Fatal(fmt.Sprintf("Expected a stale socket to be removed, got %v", err))
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatal("Stale socket was not removed")
+	}
+
+	// a mistyped path should never delete a regular file
+	ioutil.WriteFile(path, []byte("keep me"), 0644)
+	if err := removeStaleSocket(path); err == nil {
+		t.Fatal("Expected a regular file to be refused")
+	}
+	if data, err := ioutil.ReadFile(path); err != nil || string(data) != "keep me" {
+		t.Fatal(fmt.Sprintf("Regular file did not survive: %v", err))
+	}
+}
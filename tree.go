@@ -0,0 +1,102 @@
+package main
+
+// Rolls the new data sources up into a tree on their name segments, so a
+// rollout that created 240 siblings under app.checkout shows up as one node
+// with a count of 240 instead of 240 lines. Served on /tree/, which takes
+// the same filters as /json/ plus ?depth= to cut the tree off. The State
+// only holds the last 100 data sources, with ?history=1 the tree is built
+// from the create history instead (see exportDatasources).
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A name segment with the number of new data sources at or below it
+type treeNode struct {
+	Name        string      // the segment, f.ex. checkout
+	Path        string      // the full prefix, f.ex. app.checkout
+	Count       int         // new data sources in this subtree
+	Leaf        bool        `json:",omitempty"` // this path is a data source itself
+	Create_date time.Time   // most recent create in this subtree
+	Children    []*treeNode `json:",omitempty"`
+
+	index map[string]*treeNode
+}
+
+func newTreeNode(name string, path string) *treeNode {
+	return &treeNode{Name: name, Path: path, index: map[string]*treeNode{}}
+}
+
+func (n *treeNode) add(ds Datasource) {
+	node := n
+	node.count(ds)
+	for _, segment := range strings.Split(ds.Name, ".") {
+		child, ok := node.index[segment]
+		if !ok {
+			path := segment
+			if len(node.Path) > 0 {
+				path = node.Path + "." + segment
+			}
+			child = newTreeNode(segment, path)
+			node.index[segment] = child
+			node.Children = append(node.Children, child)
+		}
+		node = child
+		node.count(ds)
+	}
+	node.Leaf = true
+}
+
+func (n *treeNode) count(ds Datasource) {
+	n.Count++
+	if ds.Create_date.After(n.Create_date) {
+		n.Create_date = ds.Create_date
+	}
+}
+
+// Orders children busiest first, and drops everything below depth (0 for
+// no limit). Counts are left alone, so they still tell what's below.
+func (n *treeNode) prune(depth int) {
+	if depth == 1 {
+		n.Children = nil
+		return
+	}
+	sort.Slice(n.Children, func(i, j int) bool {
+		if n.Children[i].Count != n.Children[j].Count {
+			return n.Children[i].Count > n.Children[j].Count
+		}
+		return n.Children[i].Name < n.Children[j].Name
+	})
+	for _, child := range n.Children {
+		child.prune(depth - 1)
+	}
+}
+
+// Builds the tree over all data sources, the root has an empty Name
+func buildTree(dss []Datasource, depth int) *treeNode {
+	root := newTreeNode("", "")
+	for _, ds := range dss {
+		root.add(ds)
+	}
+	if depth > 0 {
+		depth++ // the root doesn't count
+	}
+	root.prune(depth)
+	return root
+}
+
+func treeHandler(w http.ResponseWriter, r *http.Request) {
+	depth, _ := strconv.Atoi(r.URL.Query().Get("depth"))
+	js, err := json.Marshal(buildTree(exportDatasources(r), depth))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(js)
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBuildTree(t *testing.T) {
+	now := time.Now()
+	var dss []Datasource
+	for i := 0; i < 5; i++ {
+		dss = append(dss, Datasource{Name: fmt.Sprintf("app.checkout.node%v.latency", i), Create_date: now})
+	}
+	dss = append(dss, Datasource{Name: "app.payments.count", Create_date: now.Add(-time.Hour)})
+	dss = append(dss, Datasource{Name: "app.payments", Create_date: now.Add(-time.Hour)})
+	dss = append(dss, Datasource{Name: "local.random.diceroll", Create_date: now.Add(-time.Hour)})
+
+	root := buildTree(dss, 0)
+	if root.Count != 8 || len(root.Children) != 2 || root.Children[0].Name != "app" || root.Children[0].Count != 7 {
+		t.Fatal(fmt.Sprintf("Unexpected top of tree: %+v", root))
+	}
+	app := root.Children[0]
+	if !app.Create_date.Equal(now) {
+		t.Fatal(fmt.Sprintf("Expected most recent create in subtree, got %v", app.Create_date))
+	}
+	checkout, payments := app.Children[0], app.Children[1]
+	if checkout.Path != "app.checkout" || checkout.Count != 5 || len(checkout.Children) != 5 || checkout.Leaf {
+		t.Fatal(fmt.Sprintf("Unexpected checkout node: %+v", checkout))
+	}
+	if payments.Count != 2 || !payments.Leaf || len(payments.Children) != 1 {
+		t.Fatal(fmt.Sprintf("Expected app.payments to be a data source with one below it: %+v", payments))
+	}
+
+	root = buildTree(dss, 2)
+	if len(root.Children[0].Children) != 2 || root.Children[0].Children[0].Children != nil || root.Children[0].Children[0].Count != 5 {
+		t.Fatal(fmt.Sprintf("Expected tree to be cut off at depth 2 with counts kept: %+v", root.Children[0].Children[0]))
+	}
+}
+
+func TestTreeHandler(t *testing.T) {
+	State.Vals = nil // start fresh
+	defer func() { State.Vals = nil }()
+	addItemToState(Datasource{Name: "app.checkout.a", Origin: Origin{Host: "carbon1"}})
+	addItemToState(Datasource{Name: "app.checkout.b", Origin: Origin{Host: "carbon2"}})
+
+	req, _ := http.NewRequest("GET", "/tree/?host=carbon1&depth=1", nil)
+	w := httptest.NewRecorder()
+	treeHandler(w, req)
+
+	var root treeNode
+	if err := json.Unmarshal(w.Body.Bytes(), &root); err != nil {
+		t.Fatal(fmt.Sprintf("Could not parse tree: %v", err))
+	}
+	if root.Count != 1 || len(root.Children) != 1 || root.Children[0].Children != nil {
+		t.Fatal(fmt.Sprintf("Expected filtered tree of depth 1, got %v", w.Body.String()))
+	}
+}
+
+func TestTreeHandlerHistory(t *testing.T) {
+	State.Vals = nil // start fresh
+	defer func() { State.Vals = nil }()
+	saved := history
+	history = &createHistory{&sync.RWMutex{}, nil}
+	defer func() { history = saved }()
+
+	// a rollout with more siblings than the State holds
+	for i := 0; i < 240; i++ {
+		ds := Datasource{Name: fmt.Sprintf("app.checkout.node%v", i), Create_date: time.Now()}
+		addItemToState(ds)
+		history.add(ds)
+	}
+
+	req, _ := http.NewRequest("GET", "/tree/?history=1&depth=2", nil)
+	w := httptest.NewRecorder()
+	treeHandler(w, req)
+
+	var root treeNode
+	if err := json.Unmarshal(w.Body.Bytes(), &root); err != nil {
+		t.Fatal(fmt.Sprintf("Could not parse tree: %v", err))
+	}
+	if root.Count != 240 || len(root.Children) != 1 || root.Children[0].Children[0].Count != 240 {
+		t.Fatal(fmt.Sprintf("Expected app.checkout with 240 new data sources, got %v", w.Body.String()))
+	}
+}
@@ -0,0 +1,329 @@
+package main
+
+// Keeps a longer history of creates than the State (which only holds the
+// last 100 data sources), and serves trends from it on /trends/: creates
+// per minute, hour or day, and the prefixes and hosts creating the most.
+//
+// With -hf the history is also appended to a file, one JSON entry per line,
+// which is read back on start. The file is rewritten with just what the
+// history still holds on start and whenever it has grown to twice that.
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type (
+	// A create as remembered in the history
+	historyEntry struct {
+		Name        string
+		Create_date time.Time
+		Params      string
+		Origin
+	}
+
+	createHistory struct {
+		*sync.RWMutex
+		entries []historyEntry // oldest first
+	}
+
+	trendBucket struct {
+		Time  time.Time
+		Count int
+	}
+
+	trendCount struct {
+		Name  string
+		Count int
+	}
+
+	trendReport struct {
+		Bucket   string // minute, hour or day
+		Total    int    // creates in all buckets together
+		Buckets  []trendBucket
+		Prefixes []trendCount // busiest prefixes, most creates first
+		Hosts    []trendCount // busiest origin hosts, most creates first
+	}
+)
+
+const (
+	// Creates to remember at most, and for how long
+	maxHistory    = 100000
+	maxHistoryAge = 31 * 24 * time.Hour
+
+	// Number of prefixes and hosts to list by default
+	defaultTrendTop = 10
+)
+
+// Bucket sizes and how many buckets to report for each
+var trendBuckets = map[string]struct {
+	size  time.Duration
+	count int
+}{
+	"minute": {time.Minute, 60},
+	"hour":   {time.Hour, 48},
+	"day":    {24 * time.Hour, 30},
+}
+
+var history = &createHistory{&sync.RWMutex{}, nil}
+
+func newHistoryEntry(ds Datasource) historyEntry {
+	return historyEntry{ds.Name, ds.Create_date, ds.Params, ds.Origin}
+}
+
+func (h *createHistory) add(ds Datasource) {
+	h.addEntry(newHistoryEntry(ds))
+}
+
+func (h *createHistory) addEntry(e historyEntry) {
+	h.Lock()
+	defer h.Unlock()
+
+	// data sources mostly come in order, those that don't get slotted in
+	i := sort.Search(len(h.entries), func(i int) bool { return h.entries[i].Create_date.After(e.Create_date) })
+	h.entries = append(h.entries, e)
+	if i < len(h.entries)-1 {
+		copy(h.entries[i+1:], h.entries[i:])
+		h.entries[i] = e
+	}
+
+	// Dropping moves everything after it, so only drop once there is at
+	// least a tenth to drop. Until then since skips the too old ones.
+	drop := 0
+	if len(h.entries) > maxHistory {
+		drop = len(h.entries) - maxHistory
+	}
+	cutoff := time.Now().Add(-maxHistoryAge)
+	for drop < len(h.entries) && h.entries[drop].Create_date.Before(cutoff) {
+		drop++
+	}
+	if drop > 0 && drop*10 >= len(h.entries) {
+		h.entries = append(h.entries[:0], h.entries[drop:]...)
+	}
+}
+
+// The data source a create was for, as far as the history remembers it
+func (e historyEntry) datasource() Datasource {
+	ds := Datasource{Name: e.Name, Create_date: e.Create_date, Params: e.Params, Origin: e.Origin}
+	ds.Backend = backendFor(ds)
+	return ds
+}
+
+// Returns the creates since a point in time, oldest first
+func (h *createHistory) since(t time.Time) []historyEntry {
+	h.RLock()
+	defer h.RUnlock()
+	if cutoff := time.Now().Add(-maxHistoryAge); t.Before(cutoff) {
+		t = cutoff
+	}
+	i := sort.Search(len(h.entries), func(i int) bool { return !h.entries[i].Create_date.Before(t) })
+	return append([]historyEntry(nil), h.entries[i:]...)
+}
+
+// Remembers every new data source, and appends it to the -hf file if set
+func recordHistory() {
+	l := log.New(os.Stdout, "history	", myLogFormat)
+	var f *os.File
+	lines := 0
+	open := func() {
+		var err error
+		if lines, err = compactHistory(C.historyFile); err == nil {
+			f, err = os.OpenFile(C.historyFile, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+		}
+		if err != nil {
+			l.Printf("Could not write history to %v, keeping it in memory only: %v", C.historyFile, err)
+			f = nil
+		}
+	}
+	if len(C.historyFile) > 0 {
+		open()
+	}
+
+	for ds := range subscribe() {
+		history.add(ds)
+		if f == nil {
+			continue
+		}
+		if lines >= 2*maxHistory {
+			f.Close()
+			open()
+			if f == nil {
+				continue
+			}
+		}
+		if err := appendHistory(f, newHistoryEntry(ds)); err != nil {
+			l.Printf("Could not write history to %v: %v", C.historyFile, err)
+		}
+		lines++
+	}
+}
+
+// Reads the history back from a file written by recordHistory, a missing
+// file is an empty history. Lines that can't be parsed are skipped.
+func loadHistory(file string) error {
+	l := log.New(os.Stdout, "history	", myLogFormat)
+	in, err := os.Open(file)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Could not read history from %v: %v", file, err)
+	}
+	defer in.Close()
+
+	loaded, skipped := 0, 0
+	scanner := bufio.NewScanner(in)
+	for scanner.Scan() {
+		var e historyEntry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil || len(e.Name) == 0 {
+			skipped++
+			continue
+		}
+		history.addEntry(e)
+		loaded++
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("Could not read history from %v: %v", file, err)
+	}
+	l.Printf("Loaded %v creates from %v, skipped %v broken line(s)", loaded, file, skipped)
+	return nil
+}
+
+func appendHistory(w io.Writer, e historyEntry) error {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(line, '\n'))
+	return err
+}
+
+// Rewrites the file with what the history holds now, dropping the entries
+// that got too old or too many. Returns the number of entries written.
+func compactHistory(file string) (int, error) {
+	entries := history.since(time.Time{})
+	tmp := file + ".tmp"
+	out, err := os.Create(tmp)
+	if err != nil {
+		return 0, err
+	}
+	w := bufio.NewWriter(out)
+	for _, e := range entries {
+		if err = appendHistory(w, e); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp, file)
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return 0, err
+	}
+	return len(entries), nil
+}
+
+// Builds the trends over the creates matching f, in buckets of the given
+// size up to now. Prefixes are the first depth segments of a name.
+func buildTrends(entries []historyEntry, f dsFilter, bucket string, now time.Time, depth int, top int) trendReport {
+	b := trendBuckets[bucket]
+	report := trendReport{Bucket: bucket}
+	last := now.Truncate(b.size)
+	first := last.Add(-time.Duration(b.count-1) * b.size)
+	for i := 0; i < b.count; i++ {
+		report.Buckets = append(report.Buckets, trendBucket{Time: first.Add(time.Duration(i) * b.size)})
+	}
+
+	prefixes := map[string]int{}
+	hosts := map[string]int{}
+	for _, e := range entries {
+		if e.Create_date.Before(first) || !f.match(Datasource{Name: e.Name, Origin: e.Origin}) {
+			continue
+		}
+		i := int(e.Create_date.Sub(first) / b.size)
+		if i >= len(report.Buckets) {
+			continue
+		}
+		report.Buckets[i].Count++
+		report.Total++
+
+		parts := strings.Split(e.Name, ".")
+		if len(parts) > depth {
+			parts = parts[:depth]
+		}
+		prefixes[strings.Join(parts, ".")]++
+		if len(e.Host) > 0 {
+			hosts[e.Host]++
+		}
+	}
+	report.Prefixes = topCounts(prefixes, top)
+	report.Hosts = topCounts(hosts, top)
+	return report
+}
+
+func topCounts(counts map[string]int, top int) []trendCount {
+	result := []trendCount{}
+	for name, count := range counts {
+		result = append(result, trendCount{name, count})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].Name < result[j].Name
+	})
+	if len(result) > top {
+		result = result[:top]
+	}
+	return result
+}
+
+// Serves the trends, ?bucket=minute|hour|day (default hour), ?depth= of the
+// prefixes (default 2) and ?top= number of prefixes and hosts. Takes the
+// filters of /json/ too.
+func trendsHandler(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	bucket := q.Get("bucket")
+	if len(bucket) == 0 {
+		bucket = "hour"
+	}
+	b, ok := trendBuckets[bucket]
+	if !ok {
+		http.Error(w, "bucket should be one of minute, hour or day", http.StatusBadRequest)
+		return
+	}
+	depth, _ := strconv.Atoi(q.Get("depth"))
+	if depth < 1 {
+		depth = 2
+	}
+	top, _ := strconv.Atoi(q.Get("top"))
+	if top < 1 {
+		top = defaultTrendTop
+	}
+
+	now := time.Now()
+	entries := history.since(now.Truncate(b.size).Add(-time.Duration(b.count-1) * b.size))
+	js, err := json.Marshal(buildTrends(entries, newFilter(r), bucket, now, depth, top))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(js)
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCreateHistory(t *testing.T) {
+	h := &createHistory{&sync.RWMutex{}, nil}
+	now := time.Now()
+	h.add(Datasource{Name: "a", Create_date: now.Add(-2 * time.Minute)})
+	h.add(Datasource{Name: "c", Create_date: now})
+	h.add(Datasource{Name: "b", Create_date: now.Add(-time.Minute)})
+	h.add(Datasource{Name: "old", Create_date: now.Add(-maxHistoryAge - time.Hour)})
+
+	entries := h.since(now.Add(-90 * time.Second))
+	if len(h.entries) != 3 || len(entries) != 2 || entries[0].Name != "b" || entries[1].Name != "c" {
+		t.Fatal(fmt.Sprintf("Expected history in order without old entries, got %+v", h.entries))
+	}
+}
+
+func TestCreateHistoryFull(t *testing.T) {
+	h := &createHistory{&sync.RWMutex{}, nil}
+	now := time.Now()
+	for i := 0; i < 2*maxHistory; i++ {
+		h.add(Datasource{Name: fmt.Sprintf("a.%v", i), Create_date: now.Add(time.Duration(i) * time.Millisecond)})
+	}
+	if len(h.entries) < maxHistory || len(h.entries) > maxHistory+maxHistory/9 {
+		t.Fatal(fmt.Sprintf("Expected about %v entries in a full history, got %v", maxHistory, len(h.entries)))
+	}
+	if last := h.entries[len(h.entries)-1]; last.Name != fmt.Sprintf("a.%v", 2*maxHistory-1) {
+		t.Fatal(fmt.Sprintf("Expected the newest entry to be kept, got %+v", last))
+	}
+}
+
+func TestHistoryFile(t *testing.T) {
+	saved := history
+	history = &createHistory{&sync.RWMutex{}, nil}
+	defer func() { history = saved }()
+	dir, _ := ioutil.TempDir("", "graphite-news-history")
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "history.json")
+
+	if err := loadHistory(file); err != nil || len(history.entries) > 0 {
+		t.Fatal(fmt.Sprintf("A missing history file should be an empty history, got %v (%v)", history.entries, err))
+	}
+
+	now := time.Now()
+	f, _ := os.Create(file)
+	appendHistory(f, newHistoryEntry(Datasource{Name: "app.a", Create_date: now.Add(-time.Minute), Origin: Origin{Host: "carbon1"}}))
+	appendHistory(f, newHistoryEntry(Datasource{Name: "app.old", Create_date: now.Add(-maxHistoryAge - time.Hour)}))
+	f.WriteString("{not json\n")
+	appendHistory(f, newHistoryEntry(Datasource{Name: "app.b", Create_date: now}))
+	f.Close()
+
+	if err := loadHistory(file); err != nil {
+		t.Fatal(fmt.Sprintf("Could not load history: %v", err))
+	}
+	entries := history.since(time.Time{})
+	if len(entries) != 2 || entries[0].Name != "app.a" || entries[0].Host != "carbon1" || entries[1].Name != "app.b" {
+		t.Fatal(fmt.Sprintf("Expected the recent entries back from the file, got %+v", entries))
+	}
+
+	if n, err := compactHistory(file); err != nil || n != 2 {
+		t.Fatal(fmt.Sprintf("Expected 2 entries written when compacting, got %v (%v)", n, err))
+	}
+	data, _ := ioutil.ReadFile(file)
+	if lines := strings.Split(strings.TrimSpace(string(data)), "\n"); len(lines) != 2 || strings.Contains(string(data), "app.old") {
+		t.Fatal(fmt.Sprintf("Expected the compacted file to hold just the recent entries, got %q", data))
+	}
+}
+
+func TestBuildTrends(t *testing.T) {
+	now := time.Date(2014, 9, 13, 12, 30, 0, 0, time.UTC)
+	entries := []historyEntry{
+		{"app.checkout.a", now.Add(-3 * time.Hour), "", Origin{Host: "carbon1"}},
+		{"app.checkout.b", now.Add(-10 * time.Minute), "", Origin{Host: "carbon1"}},
+		{"app.checkout.c", now.Add(-5 * time.Minute), "", Origin{Host: "carbon2"}},
+		{"local.random.diceroll", now.Add(-1 * time.Minute), "", Origin{Host: "carbon2", Instance: "carbon-cache-b"}},
+		{"ancient.metric", now.Add(-72 * time.Hour), "", Origin{Host: "carbon1"}},
+	}
+
+	report := buildTrends(entries, dsFilter{}, "hour", now, 2, 10)
+	if len(report.Buckets) != 48 || report.Total != 4 {
+		t.Fatal(fmt.Sprintf("Expected 48 buckets with 4 creates, got %v with %v", len(report.Buckets), report.Total))
+	}
+	if last := report.Buckets[47]; !last.Time.Equal(now.Truncate(time.Hour)) || last.Count != 3 || report.Buckets[44].Count != 1 {
+		t.Fatal(fmt.Sprintf("Creates ended up in the wrong buckets: %+v", report.Buckets[44:]))
+	}
+	if fmt.Sprint(report.Prefixes) != "[{app.checkout 3} {local.random 1}]" || fmt.Sprint(report.Hosts) != "[{carbon1 2} {carbon2 2}]" {
+		t.Fatal(fmt.Sprintf("Unexpected top prefixes %v or hosts %v", report.Prefixes, report.Hosts))
+	}
+
+	report = buildTrends(entries, dsFilter{Origin: Origin{Instance: "carbon-cache-b"}}, "minute", now, 1, 1)
+	if report.Total != 1 || fmt.Sprint(report.Prefixes) != "[{local 1}]" {
+		t.Fatal(fmt.Sprintf("Expected filtered trends, got %+v", report))
+	}
+}
+
+func TestTrendsHandler(t *testing.T) {
+	req, _ := http.NewRequest("GET", "/trends/?bucket=week", nil)
+	w := httptest.NewRecorder()
+	trendsHandler(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Fatal(fmt.Sprintf("Expected an unknown bucket to be refused, got %v", w.Code))
+	}
+
+	req, _ = http.NewRequest("GET", "/trends/?bucket=day", nil)
+	w = httptest.NewRecorder()
+	trendsHandler(w, req)
+	var report trendReport
+	if err := json.Unmarshal(w.Body.Bytes(), &report); err != nil || len(report.Buckets) != 30 {
+		t.Fatal(fmt.Sprintf("Expected 30 daily buckets, got %v (%v)", w.Body.String(), err))
+	}
+}
@@ -0,0 +1,237 @@
+package main
+
+// Pushes the news out: POSTs new data sources as JSON to configured
+// webhook endpoints. Endpoints are configured in a JSON file (-wh), like:
+//
+//	[{"URL": "http://example.com/hook", "Filters": ["app.payments"], "Window": "30s", "Retries": 5}]
+//
+// Every endpoint gets its own batching window and filters. Failed
+// deliveries are retried with an exponential backoff, and the outcome of
+// every delivery is kept in a log served on /webhooks/. URLs of incoming
+// webhooks (Slack and the like) are credentials, so the log and stdout only
+// ever show them redacted.
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/rcrowley/go-metrics"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+type (
+	// A single webhook endpoint, as read from the configuration file
+	webhook struct {
+		URL     string
+		Filters []string // only send data sources matching one of these globs
+		Window  string   // collect data sources for this long, then send them at once (f.ex. 30s)
+		Retries int      // number of retries after a failed delivery
+	}
+
+	// What gets POSTed to a webhook, either new data sources or an alert
+	webhookPayload struct {
+		Count       int
+		Datasources []Datasource
+		Alert       *alert `json:",omitempty"`
+	}
+
+	// Outcome of delivering a payload to a webhook
+	delivery struct {
+		URL      string
+		Time     time.Time
+		Count    int    // number of data sources delivered
+		Attempts int    // number of attempts it took
+		Status   string // HTTP status of last attempt
+		Error    string // error of the last attempt, empty on success
+	}
+
+	// The most recent deliveries
+	deliveryLog struct {
+		*sync.RWMutex
+		Vals []delivery
+	}
+)
+
+const (
+	// Number of deliveries to keep in the log
+	maxDeliveries = 100
+
+	// Wait before the first retry, doubles with each following one
+	webhookBackoff = time.Second
+)
+
+var (
+	webhookLog    = &deliveryLog{&sync.RWMutex{}, []delivery{}}
+	webhookClient = &http.Client{Timeout: 10 * time.Second}
+)
+
+// Shows a webhook URL without its secrets: no user info or query values,
+// and no last path segment (the token, as in .../services/T0/B0/<token>)
+func redactURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || len(u.Host) == 0 {
+		return "<redacted>"
+	}
+	u.User = nil
+	if u.RawQuery != "" {
+		q := u.Query()
+		for k := range q {
+			q.Set(k, "redacted")
+		}
+		u.RawQuery = q.Encode()
+	}
+	if i := strings.LastIndex(u.Path, "/"); i >= 0 && i < len(u.Path)-1 {
+		u.Path = u.Path[:i+1] + "redacted"
+	}
+	u.RawPath = ""
+	return u.String()
+}
+
+// Reads the webhook configuration file
+func loadWebhooks(file string) ([]webhook, error) {
+	var hooks []webhook
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &hooks); err != nil {
+		return nil, fmt.Errorf("Could not parse %v: %v", file, err)
+	}
+	for _, hook := range hooks {
+		if len(hook.URL) == 0 {
+			return nil, fmt.Errorf("Webhook without URL in %v", file)
+		}
+		if _, err := hook.window(); err != nil {
+			return nil, fmt.Errorf("Invalid Window for %v: %v", redactURL(hook.URL), err)
+		}
+	}
+	return hooks, nil
+}
+
+func (hook webhook) window() (time.Duration, error) {
+	if len(hook.Window) == 0 {
+		return 0, nil
+	}
+	return time.ParseDuration(hook.Window)
+}
+
+// Loads the configured webhooks and starts a notifier for each of them
+func startWebhooks(c chan string) {
+	if len(C.webhookFile) == 0 {
+		return
+	}
+	l := log.New(os.Stdout, "webhook	", myLogFormat)
+
+	hooks, err := loadWebhooks(C.webhookFile)
+	if err != nil {
+		c <- fmt.Sprintf("%s", err)
+		return
+	}
+	setAlertWebhooks(hooks)
+	for _, hook := range hooks {
+		l.Printf("Sending new data sources to %v (filters: %v, window: %v)", redactURL(hook.URL), hook.Filters, hook.Window)
+		go runWebhook(hook, subscribe())
+	}
+}
+
+// Feeds a webhook with all data sources from in that pass its filters,
+// until in is closed and the last of them are delivered
+func runWebhook(hook webhook, in chan Datasource) {
+	filtered := make(chan Datasource, subscriberBuffer)
+	window, _ := hook.window()
+
+	done := make(chan bool)
+	go func() {
+		batchDatasources(filtered, window, func(dss []Datasource) {
+			deliverWebhook(hook, dss)
+		})
+		close(done)
+	}()
+	for ds := range in {
+		if matchesAnyPrefix(hook.Filters, ds.Name) {
+			filtered <- ds
+		}
+	}
+	close(filtered)
+	<-done
+}
+
+// POSTs data sources to a webhook
+func deliverWebhook(hook webhook, dss []Datasource) delivery {
+	body, _ := json.Marshal(webhookPayload{Count: len(dss), Datasources: dss})
+	return postWithRetries(hook.URL, body, hook.Retries, len(dss))
+}
+
+// POSTs a JSON body, retrying with backoff until it works or we run out of
+// retries. Any 2xx response counts as delivered. The outcome is logged in
+// the delivery log.
+func postWithRetries(target string, body []byte, retries int, count int) delivery {
+	l := log.New(os.Stdout, "webhook	", myLogFormat)
+	m := metrics.GetOrRegisterCounter("webhook.failures", metrics.DefaultRegistry)
+
+	d := delivery{URL: redactURL(target), Time: time.Now(), Count: count}
+	backoff := webhookBackoff
+
+	for d.Attempts = 1; ; d.Attempts++ {
+		resp, err := webhookClient.Post(target, "application/json", bytes.NewReader(body))
+		if ue, ok := err.(*url.Error); ok {
+			err = ue.Err // the error would repeat the URL
+		}
+		if err == nil {
+			resp.Body.Close()
+			d.Status = resp.Status
+			if resp.StatusCode < 200 || resp.StatusCode > 299 {
+				err = fmt.Errorf("unexpected status %v", resp.Status)
+			}
+		}
+		if err == nil {
+			d.Error = ""
+			break
+		}
+
+		d.Error = err.Error()
+		m.Inc(1)
+		if d.Attempts > retries {
+			break
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+
+	if len(d.Error) > 0 {
+		l.Printf("Failed to deliver %v data sources to %v in %v attempt(s): %v", d.Count, d.URL, d.Attempts, d.Error)
+	} else {
+		l.Printf("Delivered %v data sources to %v in %v attempt(s)", d.Count, d.URL, d.Attempts)
+	}
+	webhookLog.add(d)
+	return d
+}
+
+func (dl *deliveryLog) add(d delivery) {
+	dl.Lock()
+	defer dl.Unlock()
+	dl.Vals = append(dl.Vals, d)
+	if len(dl.Vals) > maxDeliveries {
+		dl.Vals = dl.Vals[len(dl.Vals)-maxDeliveries:]
+	}
+}
+
+func webhooksHandler(w http.ResponseWriter, r *http.Request) {
+	webhookLog.RLock()
+	js, err := json.Marshal(webhookLog.Vals)
+	webhookLog.RUnlock()
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(js)
+}
@@ -0,0 +1,209 @@
+package main
+
+// Reads whisper files, the round robin databases carbon writes. The layout:
+//
+//	metadata:   aggregation uint32, max retention uint32, xff float32, archive count uint32
+//	archives:   offset uint32, seconds per point uint32, points uint32 (each)
+//	data:       per archive, points of timestamp uint32 and value float64
+//
+// all big endian. A point that was never written has a timestamp of 0.
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"time"
+)
+
+type (
+	whisperArchive struct {
+		Offset          uint32 `json:"-"`
+		SecondsPerPoint uint32
+		Points          uint32
+		Retention       string // f.ex. 60s:365d
+	}
+
+	whisperHeader struct {
+		Aggregation  string // average, sum, last, max or min
+		MaxRetention uint32 // seconds
+		XFilesFactor float32
+		Archives     []whisperArchive
+	}
+
+	whisperPoint struct {
+		Timestamp uint32
+		Value     float64
+	}
+
+	whisperFile struct {
+		*os.File
+		Header whisperHeader
+	}
+)
+
+const (
+	whisperMetadataSize    = 16
+	whisperArchiveInfoSize = 12
+	whisperPointSize       = 12
+)
+
+var whisperAggregations = []string{"", "average", "sum", "last", "max", "min", "avg_zero", "absmax", "absmin"}
+
+// Opens a whisper file and reads its header
+func openWhisper(filename string) (*whisperFile, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	w := &whisperFile{File: f}
+	if w.Header, err = readWhisperHeader(f); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("%v: %v", filename, err)
+	}
+	return w, nil
+}
+
+func readWhisperHeader(r io.Reader) (whisperHeader, error) {
+	var h whisperHeader
+	var meta struct {
+		Aggregation  uint32
+		MaxRetention uint32
+		XFilesFactor float32
+		ArchiveCount uint32
+	}
+	if err := binary.Read(r, binary.BigEndian, &meta); err != nil {
+		return h, fmt.Errorf("could not read whisper header: %v", err)
+	}
+	if meta.ArchiveCount == 0 || meta.ArchiveCount > 64 {
+		return h, fmt.Errorf("not a whisper file, %v archives", meta.ArchiveCount)
+	}
+	h.MaxRetention = meta.MaxRetention
+	h.XFilesFactor = meta.XFilesFactor
+	h.Aggregation = fmt.Sprintf("unknown (%v)", meta.Aggregation)
+	if int(meta.Aggregation) < len(whisperAggregations) && meta.Aggregation > 0 {
+		h.Aggregation = whisperAggregations[meta.Aggregation]
+	}
+
+	for i := uint32(0); i < meta.ArchiveCount; i++ {
+		var a whisperArchive
+		var info [3]uint32
+		if err := binary.Read(r, binary.BigEndian, &info); err != nil {
+			return h, fmt.Errorf("could not read archive %v: %v", i, err)
+		}
+		a.Offset, a.SecondsPerPoint, a.Points = info[0], info[1], info[2]
+		if a.SecondsPerPoint == 0 || a.Points == 0 {
+			return h, fmt.Errorf("archive %v is empty", i)
+		}
+		a.Retention = fmt.Sprintf("%v:%v", formatSeconds(a.SecondsPerPoint), formatSeconds(a.SecondsPerPoint*a.Points))
+		h.Archives = append(h.Archives, a)
+	}
+	return h, nil
+}
+
+// Formats seconds the way storage-schemas.conf does: 60s, 5m, 1h, 365d, 1y
+func formatSeconds(s uint32) string {
+	for _, unit := range []struct {
+		suffix  string
+		seconds uint32
+	}{{"y", 365 * 86400}, {"w", 7 * 86400}, {"d", 86400}, {"h", 3600}, {"m", 60}} {
+		if s >= unit.seconds && s%unit.seconds == 0 {
+			return fmt.Sprintf("%v%v", s/unit.seconds, unit.suffix)
+		}
+	}
+	return fmt.Sprintf("%vs", s)
+}
+
+// Reads all points of an archive, in the order they are on disk
+func (w *whisperFile) readArchive(a whisperArchive) ([]whisperPoint, error) {
+	buf := make([]byte, int(a.Points)*whisperPointSize)
+	if _, err := w.ReadAt(buf, int64(a.Offset)); err != nil {
+		return nil, fmt.Errorf("could not read archive at %v: %v", a.Offset, err)
+	}
+	points := make([]whisperPoint, a.Points)
+	for i := range points {
+		p := buf[i*whisperPointSize:]
+		points[i].Timestamp = binary.BigEndian.Uint32(p)
+		points[i].Value = math.Float64frombits(binary.BigEndian.Uint64(p[4:]))
+	}
+	return points, nil
+}
+
+// Counts the points in an archive that have been written and are still
+// within its retention, and returns the most recent timestamp among them
+func (w *whisperFile) writtenPoints(a whisperArchive, now time.Time) (int, time.Time, error) {
+	points, err := w.readArchive(a)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	oldest := now.Unix() - int64(a.SecondsPerPoint*a.Points)
+	var count int
+	var last uint32
+	for _, p := range points {
+		if p.Timestamp == 0 || int64(p.Timestamp) <= oldest || math.IsNaN(p.Value) {
+			continue
+		}
+		count++
+		if p.Timestamp > last {
+			last = p.Timestamp
+		}
+	}
+	if last == 0 {
+		return count, time.Time{}, nil
+	}
+	return count, time.Unix(int64(last), 0), nil
+}
+
+// Returns the values between from and until (unix seconds, from exclusive)
+// from the most precise archive that covers from, like whisper.fetch does.
+// Values are nil where no point was written. Returns the timestamp of the
+// first value and the seconds between values.
+func (w *whisperFile) fetch(from int64, until int64, now int64) ([]*float64, int64, int64, error) {
+	if from > until {
+		return nil, 0, 0, fmt.Errorf("from (%v) is after until (%v)", from, until)
+	}
+	if oldest := now - int64(w.Header.MaxRetention); from < oldest {
+		from = oldest
+	}
+	if until > now {
+		until = now
+	}
+	if until < from {
+		return nil, 0, 0, nil
+	}
+
+	archive := w.Header.Archives[len(w.Header.Archives)-1]
+	for _, a := range w.Header.Archives {
+		if int64(a.SecondsPerPoint*a.Points) >= now-from {
+			archive = a
+			break
+		}
+	}
+	step := int64(archive.SecondsPerPoint)
+	fromInterval := from - from%step + step
+	untilInterval := until - until%step + step
+	if fromInterval == untilInterval {
+		untilInterval += step
+	}
+
+	points, err := w.readArchive(archive)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	n := int64(len(points))
+	values := make([]*float64, (untilInterval-fromInterval)/step)
+	base := int64(points[0].Timestamp)
+	if base == 0 {
+		return values, fromInterval, step, nil // nothing written yet
+	}
+	for i := range values {
+		ts := fromInterval + int64(i)*step
+		p := points[(((ts-base)/step)%n+n)%n]
+		if int64(p.Timestamp) == ts && !math.IsNaN(p.Value) {
+			v := p.Value
+			values[i] = &v
+		}
+	}
+	return values, fromInterval, step, nil
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+)
+
+// Writes a whisper file with archives of {seconds per point, points} and
+// the given points in the first archive, returns its name
+func writeTestWhisper(t *testing.T, archives [][2]uint32, points []whisperPoint) string {
+	var buf bytes.Buffer
+	offset := uint32(whisperMetadataSize + whisperArchiveInfoSize*len(archives))
+	binary.Write(&buf, binary.BigEndian, []uint32{1, archives[len(archives)-1][0] * archives[len(archives)-1][1]})
+	binary.Write(&buf, binary.BigEndian, float32(0.5))
+	binary.Write(&buf, binary.BigEndian, uint32(len(archives)))
+	for _, a := range archives {
+		binary.Write(&buf, binary.BigEndian, []uint32{offset, a[0], a[1]})
+		offset += a[1] * whisperPointSize
+	}
+	data := make([]byte, offset-uint32(buf.Len()))
+	first := archives[0]
+	for _, p := range points {
+		// like whisper, the first point written goes in the first slot
+		i := ((int64(p.Timestamp)-int64(points[0].Timestamp))/int64(first[0])%int64(first[1]) + int64(first[1])) % int64(first[1]) * whisperPointSize
+		binary.BigEndian.PutUint32(data[i:], p.Timestamp)
+		binary.BigEndian.PutUint64(data[i+4:], math.Float64bits(p.Value))
+	}
+	buf.Write(data)
+
+	file, err := ioutil.TempFile("", "graphite-news-whisper")
+	if err != nil {
+		t.Fatal(fmt.Sprintf("Could not create whisper file: %v", err))
+	}
+	file.Write(buf.Bytes())
+	file.Close()
+	return file.Name()
+}
+
+func TestWhisperHeader(t *testing.T) {
+	now := time.Now()
+	ts := uint32(now.Unix()) / 60 * 60
+	filename := writeTestWhisper(t, [][2]uint32{{60, 1440}, {3600, 8760}}, []whisperPoint{
+		{ts - 120, 1}, {ts - 60, 2}, {ts, 3},
+		{ts - 86400 - 300, 4}, // out of retention, left over from a previous round
+	})
+	defer os.Remove(filename)
+
+	info := readWhisperInfo(filename, now)
+	if len(info.Error) > 0 || info.Aggregation != "average" || info.XFilesFactor != 0.5 || info.MaxRetention != 3600*8760 {
+		t.Fatal(fmt.Sprintf("Unexpected whisper header: %+v", info))
+	}
+	if len(info.Archives) != 2 || info.Archives[0].Retention != "1m:1d" || info.Archives[1].Retention != "1h:1y" {
+		t.Fatal(fmt.Sprintf("Unexpected archives: %+v", info.Archives))
+	}
+	if info.Points != 3 || info.LastUpdate.Unix() != int64(ts) || info.Size != int64(16+2*12+(1440+8760)*12) {
+		t.Fatal(fmt.Sprintf("Expected 3 points, last at %v, got %+v", ts, info))
+	}
+
+	ioutil.WriteFile(filename, []byte("not whisper"), 0644)
+	if info := readWhisperInfo(filename, now); len(info.Error) == 0 {
+		t.Fatal("Expected an error reading a file that is not whisper")
+	}
+}
+
+func TestDatasourceHandler(t *testing.T) {
+	State.Vals = nil // start fresh
+	defer func() { State.Vals = nil }()
+	filename := writeTestWhisper(t, [][2]uint32{{60, 10}}, []whisperPoint{{uint32(time.Now().Unix()) / 60 * 60, 42}})
+	defer os.Remove(filename)
+	addItemToState(Datasource{Name: "local.random.diceroll", filename: filename})
+	addItemToState(Datasource{Name: "app.ingested.count"})
+
+	req, _ := http.NewRequest("GET", "/datasource/local.random.diceroll", nil)
+	w := httptest.NewRecorder()
+	datasourceHandler(w, req)
+	var info datasourceInfo
+	if err := json.Unmarshal(w.Body.Bytes(), &info); err != nil || info.Name != "local.random.diceroll" || info.Whisper == nil || info.Whisper.Points != 1 {
+		t.Fatal(fmt.Sprintf("Expected data source with whisper info, got %v", w.Body.String()))
+	}
+
+	req, _ = http.NewRequest("GET", "/datasource/app.ingested.count", nil)
+	w = httptest.NewRecorder()
+	datasourceHandler(w, req)
+	info = datasourceInfo{}
+	if json.Unmarshal(w.Body.Bytes(), &info); info.Name != "app.ingested.count" || info.Whisper != nil {
+		t.Fatal(fmt.Sprintf("Expected data source without whisper info, got %v", w.Body.String()))
+	}
+
+	req, _ = http.NewRequest("GET", "/datasource/no.such.thing", nil)
+	w = httptest.NewRecorder()
+	datasourceHandler(w, req)
+	if w.Code != http.StatusNotFound {
+		t.Fatal(fmt.Sprintf("Expected 404 for unknown data source, got %v", w.Code))
+	}
+}